@@ -2,27 +2,47 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"os"
+	"sort"
+	"strings"
 
 	"go-kube-get/pkg/gokubeget"
+	"go-kube-get/pkg/gokubeget/printers"
 	"path/filepath"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/util/homedir"
 )
 
 func main() {
-	if len(os.Args) < 2 {
-		fmt.Fprintf(os.Stderr, "Usage: %s <resource-name> [namespace]\n", os.Args[0])
+	labelSelector := flag.String("l", "", "Label selector to filter resources (e.g. -l app=foo)")
+	fieldSelector := flag.String("field-selector", "", "Field selector to filter resources (e.g. --field-selector status.phase=Running)")
+	allNamespaces := flag.Bool("A", false, "List the requested resource across all namespaces")
+	watch := flag.Bool("w", false, "Watch for changes after listing")
+	output := flag.String("o", "table", "Output format: table, wide, name, json, yaml, jsonpath=..., go-template=...")
+	refreshCache := flag.Bool("refresh-cache", false, "Ignore the on-disk discovery cache and re-fetch it from the API server")
+	contexts := flag.String("contexts", "", "Comma-separated kubeconfig contexts to fan the get out to")
+	allContexts := flag.Bool("all-contexts", false, "Fan the get out to every context in the kubeconfig")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [flags] <resource-name> [namespace]\n", os.Args[0])
 		fmt.Fprintf(os.Stderr, "Example: %s pods default\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	args := flag.Args()
+	if len(args) < 1 {
+		flag.Usage()
 		os.Exit(1)
 	}
 
-	resourceName := os.Args[1]
+	resourceName := args[0]
 	var namespace string
-	if len(os.Args) > 2 {
-		namespace = os.Args[2]
+	if len(args) > 1 {
+		namespace = args[1]
 	}
 
 	kubeconfig := filepath.Join(homedir.HomeDir(), ".kube", "config")
@@ -30,6 +50,15 @@ func main() {
 		kubeconfig = envKubeconfig
 	}
 
+	if *allContexts || *contexts != "" {
+		runMultiCluster(kubeconfig, resourceName, namespace, *contexts, *allContexts, gokubeget.GetOptions{
+			LabelSelector: *labelSelector,
+			FieldSelector: *fieldSelector,
+			AllNamespaces: *allNamespaces,
+		})
+		return
+	}
+
 	config, err := clientcmd.BuildConfigFromFlags("", kubeconfig)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Failed to load kubeconfig: %v\n", err)
@@ -44,41 +73,108 @@ func main() {
 	}
 
 	currentContext := clientConfig.CurrentContext
-	defaultNamespace := "default"
-	if context, exists := clientConfig.Contexts[currentContext]; exists && context.Namespace != "" {
-		defaultNamespace = context.Namespace
+	if namespace == "" {
+		namespace = "default"
+		if context, exists := clientConfig.Contexts[currentContext]; exists && context.Namespace != "" {
+			namespace = context.Namespace
+		}
 	}
 
-	kubeget, err := gokubeget.NewKubeGet(config, defaultNamespace)
+	kubeget, err := gokubeget.NewKubeGet(config)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Failed to create kubeget client: %v\n", err)
 		os.Exit(1)
 	}
 
+	if *refreshCache {
+		kubeget.Invalidate()
+	}
+
+	format, err := printers.ParseFormat(*output)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+
 	ctx := context.Background()
-	gvr, resourceList, err := kubeget.Get(ctx, resourceName, namespace)
+	getOpts := gokubeget.GetOptions{
+		LabelSelector: *labelSelector,
+		FieldSelector: *fieldSelector,
+		AllNamespaces: *allNamespaces,
+	}
+	_, resourceList, err := kubeget.GetWithOptions(ctx, resourceName, namespace, getOpts)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Failed to get resources: %v\n", err)
 		os.Exit(1)
 	}
 
-	// Show which namespace was actually used
-	actualNamespace := namespace
-	if actualNamespace == "" {
-		actualNamespace = defaultNamespace
+	fetchTable := func() (*metav1.Table, error) {
+		return kubeget.FetchTable(ctx, resourceName, namespace, getOpts)
+	}
+	if err := printers.Print(os.Stdout, resourceList, format, fetchTable, *allNamespaces); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to render resources: %v\n", err)
+		os.Exit(1)
 	}
 
-	fmt.Printf("Resource: %s (Group: %s, Version: %s, Resource: %s)\n",
-		resourceName, gvr.Group, gvr.Version, gvr.Resource)
-	fmt.Printf("Namespace: %s\n\n", actualNamespace)
-
-	if len(resourceList.Items) == 0 {
-		fmt.Println("No resources found.")
+	if !*watch {
 		return
 	}
 
-	fmt.Printf("Found %d resource(s):\n", len(resourceList.Items))
-	for i, item := range resourceList.Items {
-		fmt.Printf("%d. %s\n", i+1, item.GetName())
+	fmt.Println("\nWatching for changes...")
+	eventCh, err := kubeget.Watch(ctx, resourceName, namespace, gokubeget.WatchOptions{
+		LabelSelector: *labelSelector,
+		FieldSelector: *fieldSelector,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to watch resources: %v\n", err)
+		os.Exit(1)
+	}
+
+	for event := range eventCh {
+		fmt.Printf("%s\t%s\n", event.Type, event.Object.GetName())
+	}
+}
+
+// runMultiCluster fans resourceName out to several kubeconfig contexts and prints the
+// results grouped by context. Of opts only AllNamespaces is honored; label and field
+// selectors are not applied to the multi-cluster get.
+func runMultiCluster(kubeconfig, resourceName, namespace, contexts string, allContexts bool, opts gokubeget.GetOptions) {
+	if opts.AllNamespaces {
+		namespace = ""
+	}
+
+	var contextNames []string
+	if !allContexts {
+		contextNames = strings.Split(contexts, ",")
+	}
+
+	multiKubeGet, err := gokubeget.NewMultiKubeGetFromKubeconfig(kubeconfig, contextNames)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to set up multi-cluster client: %v\n", err)
+		os.Exit(1)
+	}
+
+	results := multiKubeGet.Get(context.Background(), resourceName, namespace)
+
+	names := make([]string, 0, len(results))
+	for name := range results {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		result := results[name]
+		fmt.Printf("Context: %s\n", name)
+
+		if result.Err != nil {
+			fmt.Fprintf(os.Stderr, "  error: %v\n", result.Err)
+			continue
+		}
+
+		tableFormat, _ := printers.ParseFormat("table")
+		if err := printers.Print(os.Stdout, result.List, tableFormat, nil, opts.AllNamespaces); err != nil {
+			fmt.Fprintf(os.Stderr, "  error: failed to render resources: %v\n", err)
+		}
+		fmt.Println()
 	}
 }
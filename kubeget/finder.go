@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
+
+	"go-kube-get/kubeget/internal/lazyrestmapper"
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -13,7 +16,6 @@ import (
 	"k8s.io/client-go/discovery/cached/memory"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/rest"
-	"k8s.io/client-go/restmapper"
 )
 
 // Finder provides a kubectl get-like interface for fetching Kubernetes resources
@@ -21,14 +23,51 @@ type Finder struct {
 	restMapper      meta.RESTMapper
 	dynamicClient   dynamic.Interface
 	discoveryClient discovery.CachedDiscoveryInterface
+
+	watchErrsOnce sync.Once
+	watchErrs     chan error
+}
+
+// RESTMapperFactory builds a meta.RESTMapper from the Finder's cached discovery
+// client, so an Option can swap out how GVKs/GVRs get resolved without having to
+// construct the discovery client itself.
+type RESTMapperFactory func(discovery.CachedDiscoveryInterface) meta.RESTMapper
+
+// Option configures a Finder created by NewFinder.
+type Option func(*finderOptions)
+
+type finderOptions struct {
+	restMapperFactory RESTMapperFactory
+}
+
+// WithRESTMapper overrides how NewFinder builds its meta.RESTMapper. The default is a
+// lazy mapper (kubeget/internal/lazyrestmapper) that only fetches a group-version's
+// resources the first time it's actually needed. Pass a factory wrapping
+// restmapper.NewDeferredDiscoveryRESTMapper to opt back into the previous,
+// eager-on-cache-miss behavior:
+//
+//	kubeget.WithRESTMapper(func(c discovery.CachedDiscoveryInterface) meta.RESTMapper {
+//		return restmapper.NewDeferredDiscoveryRESTMapper(c)
+//	})
+func WithRESTMapper(factory RESTMapperFactory) Option {
+	return func(o *finderOptions) { o.restMapperFactory = factory }
 }
 
 // NewFinder creates a new Finder instance using the provided Kubernetes configuration
-func NewFinder(config *rest.Config) (*Finder, error) {
+func NewFinder(config *rest.Config, opts ...Option) (*Finder, error) {
 	if config == nil {
 		return nil, fmt.Errorf("config cannot be nil")
 	}
 
+	options := finderOptions{
+		restMapperFactory: func(c discovery.CachedDiscoveryInterface) meta.RESTMapper {
+			return lazyrestmapper.New(c)
+		},
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create discovery client: %w", err)
@@ -37,7 +76,7 @@ func NewFinder(config *rest.Config) (*Finder, error) {
 	// Wrap discovery client with memory cache for efficient resource discovery
 	cachedClient := memory.NewMemCacheClient(discoveryClient)
 
-	restMapper := restmapper.NewDeferredDiscoveryRESTMapper(cachedClient)
+	restMapper := options.restMapperFactory(cachedClient)
 
 	dynamicClient, err := dynamic.NewForConfig(config)
 	if err != nil {
@@ -58,14 +97,25 @@ func (f *Finder) Get(ctx context.Context, resourceName, namespace string) (schem
 		return schema.GroupVersionResource{}, nil, fmt.Errorf("failed to find resource %q: %w", resourceName, err)
 	}
 
-	var resourceInterface dynamic.ResourceInterface
-	if namespace != "" {
-		resourceInterface = f.dynamicClient.Resource(gvr).Namespace(namespace)
-	} else {
-		resourceInterface = f.dynamicClient.Resource(gvr)
+	return f.list(ctx, gvr, namespace)
+}
+
+// GetWithGVK retrieves resources for an exact GroupVersionKind, bypassing name
+// resolution entirely. Use this (or the "<resource>.<group>" form Get/findGVR accept)
+// when two CRDs expose the same Kind in different groups and a bare-name lookup would
+// otherwise return an *AmbiguousResourceError.
+func (f *Finder) GetWithGVK(ctx context.Context, gvk schema.GroupVersionKind, namespace string) (schema.GroupVersionResource, *unstructured.UnstructuredList, error) {
+	gvr, err := f.findGVRForGVK(gvk)
+	if err != nil {
+		return schema.GroupVersionResource{}, nil, fmt.Errorf("failed to find resource for %s: %w", gvk, err)
 	}
 
-	list, err := resourceInterface.List(ctx, metav1.ListOptions{})
+	return f.list(ctx, gvr, namespace)
+}
+
+// list fetches a single already-resolved GVR.
+func (f *Finder) list(ctx context.Context, gvr schema.GroupVersionResource, namespace string) (schema.GroupVersionResource, *unstructured.UnstructuredList, error) {
+	list, err := f.resourceInterface(gvr, namespace).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return gvr, nil, fmt.Errorf("failed to list resources: %w", err)
 	}
@@ -73,6 +123,43 @@ func (f *Finder) Get(ctx context.Context, resourceName, namespace string) (schem
 	return gvr, list, nil
 }
 
+// resourceInterface returns the dynamic client handle for gvr, scoped to namespace when
+// one is given.
+func (f *Finder) resourceInterface(gvr schema.GroupVersionResource, namespace string) dynamic.ResourceInterface {
+	if namespace != "" {
+		return f.dynamicClient.Resource(gvr).Namespace(namespace)
+	}
+	return f.dynamicClient.Resource(gvr)
+}
+
+// findGVRForGVK resolves an exact GroupVersionKind to its GroupVersionResource,
+// without going through any of findGVR's ambiguous bare-name matching.
+func (f *Finder) findGVRForGVK(gvk schema.GroupVersionKind) (schema.GroupVersionResource, error) {
+	mapping, err := f.restMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return schema.GroupVersionResource{}, fmt.Errorf("no resource found for %s: %w", gvk, err)
+	}
+	return mapping.Resource, nil
+}
+
+// AmbiguousResourceError is returned by findGVR when a bare resource or kind name
+// matches more than one GroupVersionResource, e.g. two CRDs exposing the same Kind in
+// different groups. Callers should re-invoke with a qualified "<resource>.<group>"
+// form, or GetWithGVK, to pick one of Candidates explicitly.
+type AmbiguousResourceError struct {
+	ResourceName string
+	Candidates   []schema.GroupVersionResource
+}
+
+func (e *AmbiguousResourceError) Error() string {
+	candidates := make([]string, 0, len(e.Candidates))
+	for _, gvr := range e.Candidates {
+		candidates = append(candidates, gvr.String())
+	}
+	return fmt.Sprintf("%q is ambiguous, matches multiple resources: %s (re-invoke as \"<resource>.<group>\" or use GetWithGVK to disambiguate)",
+		e.ResourceName, strings.Join(candidates, ", "))
+}
+
 // findGVR resolves a resource name (kind, plural, or shortname) to its GroupVersionResource
 func (f *Finder) findGVR(resourceName string) (schema.GroupVersionResource, error) {
 	if resourceName == "" {
@@ -81,7 +168,8 @@ func (f *Finder) findGVR(resourceName string) (schema.GroupVersionResource, erro
 	// Handle fully qualified resource names like "datasciencepipelinesapplications.v1.datasciencepipelinesapplications.opendatahub.io"
 	if strings.Contains(resourceName, ".") {
 		parts := strings.Split(resourceName, ".")
-		if len(parts) >= 3 {
+		switch {
+		case len(parts) >= 3:
 			// Format: resource.version.group (may have multiple dots in group)
 			resourceOnly := parts[0]
 			version := parts[1]
@@ -93,6 +181,10 @@ func (f *Finder) findGVR(resourceName string) (schema.GroupVersionResource, erro
 				Version:  version,
 				Resource: resourceOnly,
 			}, nil
+		case len(parts) == 2:
+			// Format: resource.group (no version) - the common kubectl shorthand for
+			// disambiguating a Kind/resource that's exposed by more than one group.
+			return f.findGVRForGroup(parts[0], parts[1])
 		}
 	}
 
@@ -103,25 +195,31 @@ func (f *Finder) findGVR(resourceName string) (schema.GroupVersionResource, erro
 	if err == nil {
 		return gvr, nil
 	}
+	if ambiguous, ok := err.(*meta.AmbiguousResourceError); ok {
+		return schema.GroupVersionResource{}, &AmbiguousResourceError{ResourceName: resourceName, Candidates: ambiguous.MatchingResources}
+	}
 
 	// Try to find by kind name (case-insensitive search across all groups)
-	mappings, err := f.restMapper.RESTMappings(schema.GroupKind{Kind: resourceName})
-	if err == nil && len(mappings) > 0 {
-		return mappings[0].Resource, nil
+	if gvr, err := f.resolveKind(resourceName); err == nil {
+		return gvr, nil
+	} else if ambiguous, ok := err.(*AmbiguousResourceError); ok {
+		return schema.GroupVersionResource{}, ambiguous
 	}
 
 	// Try case variations for kind names (e.g., "dspa" -> "DSPA")
 	kindVariations := []string{
-		resourceName,
 		strings.Title(resourceName),
 		strings.ToUpper(resourceName),
 		strings.ToUpper(string(resourceName[0])) + strings.ToLower(resourceName[1:]),
 	}
 
 	for _, kind := range kindVariations {
-		mappings, err := f.restMapper.RESTMappings(schema.GroupKind{Kind: kind})
-		if err == nil && len(mappings) > 0 {
-			return mappings[0].Resource, nil
+		gvr, err := f.resolveKind(kind)
+		if err == nil {
+			return gvr, nil
+		}
+		if ambiguous, ok := err.(*AmbiguousResourceError); ok {
+			return schema.GroupVersionResource{}, ambiguous
 		}
 	}
 
@@ -159,3 +257,39 @@ func (f *Finder) findGVR(resourceName string) (schema.GroupVersionResource, erro
 
 	return schema.GroupVersionResource{}, fmt.Errorf("failed to find resource %q: resource not found in any API group", resourceName)
 }
+
+// resolveKind looks up a Kind through the REST mapper's RESTMappings with an empty Group,
+// returning an *AmbiguousResourceError instead of silently picking one when that lookup
+// yields more than one mapping. Which groups an empty Group covers is up to the mapper:
+// the default lazy mapper searches only the core group, so a Kind exposed by two
+// non-core groups is not detected as ambiguous here.
+func (f *Finder) resolveKind(kind string) (schema.GroupVersionResource, error) {
+	mappings, err := f.restMapper.RESTMappings(schema.GroupKind{Kind: kind})
+	if err != nil || len(mappings) == 0 {
+		return schema.GroupVersionResource{}, fmt.Errorf("kind %q not found", kind)
+	}
+	if len(mappings) == 1 {
+		return mappings[0].Resource, nil
+	}
+
+	candidates := make([]schema.GroupVersionResource, 0, len(mappings))
+	for _, m := range mappings {
+		candidates = append(candidates, m.Resource)
+	}
+	return schema.GroupVersionResource{}, &AmbiguousResourceError{ResourceName: kind, Candidates: candidates}
+}
+
+// findGVRForGroup resolves the "<resource>.<group>" form (no version given) by trying
+// every version of group the REST mapper knows about, first as a resource name, then as
+// a Kind.
+func (f *Finder) findGVRForGroup(resourceOrKind, group string) (schema.GroupVersionResource, error) {
+	if gvrs, err := f.restMapper.ResourcesFor(schema.GroupVersionResource{Group: group, Resource: resourceOrKind}); err == nil && len(gvrs) > 0 {
+		return gvrs[0], nil
+	}
+
+	if mappings, err := f.restMapper.RESTMappings(schema.GroupKind{Group: group, Kind: resourceOrKind}); err == nil && len(mappings) > 0 {
+		return mappings[0].Resource, nil
+	}
+
+	return schema.GroupVersionResource{}, fmt.Errorf("resource %q not found in group %q", resourceOrKind, group)
+}
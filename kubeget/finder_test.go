@@ -2,9 +2,16 @@ package kubeget
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 
+	"go-kube-get/kubeget/internal/lazyrestmapper"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/rest"
 )
 
@@ -236,6 +243,206 @@ func TestGet_ErrorHandling(t *testing.T) {
 	}
 }
 
+func TestFindGVR_AmbiguousKind(t *testing.T) {
+	mapper := &fakeRESTMapper{
+		mappings: map[string][]*meta.RESTMapping{
+			"Widget": {
+				{Resource: schema.GroupVersionResource{Group: "appsgroup", Version: "v1", Resource: "widgets"}},
+				{Resource: schema.GroupVersionResource{Group: "othergroup", Version: "v1", Resource: "widgets"}},
+			},
+		},
+	}
+
+	finder, err := NewFinder(&rest.Config{Host: "https://localhost:8443"}, WithRESTMapper(func(discovery.CachedDiscoveryInterface) meta.RESTMapper {
+		return mapper
+	}))
+	if err != nil {
+		t.Fatalf("failed to create finder: %v", err)
+	}
+
+	_, _, err = finder.Get(context.Background(), "Widget", "default")
+
+	var ambiguous *AmbiguousResourceError
+	if !errors.As(err, &ambiguous) {
+		t.Fatalf("expected *AmbiguousResourceError, got %T: %v", err, err)
+	}
+	if len(ambiguous.Candidates) != 2 {
+		t.Errorf("expected 2 candidates, got %d", len(ambiguous.Candidates))
+	}
+}
+
+// TestFindGVR_AmbiguousPluralResourceName exercises ambiguity detection through the
+// real lazyrestmapper.Mapper rather than fakeRESTMapper, which hardcodes ResourceFor to
+// always fail and so never exercises findGVR's ResourceFor-based path at all. Two
+// distinct groups, "foo.example.com" and "bar.example.com", both expose a "widgets"
+// resource; Get must report this as ambiguous instead of silently resolving to
+// whichever group the mapper happened to check first.
+func TestFindGVR_AmbiguousPluralResourceName(t *testing.T) {
+	fake := &fakeDiscoveryClient{
+		groups: &metav1.APIGroupList{Groups: []metav1.APIGroup{
+			{Name: "foo.example.com", Versions: []metav1.GroupVersionForDiscovery{{GroupVersion: "foo.example.com/v1", Version: "v1"}}},
+			{Name: "bar.example.com", Versions: []metav1.GroupVersionForDiscovery{{GroupVersion: "bar.example.com/v1", Version: "v1"}}},
+		}},
+		resources: map[string]*metav1.APIResourceList{
+			"foo.example.com/v1": {
+				GroupVersion: "foo.example.com/v1",
+				APIResources: []metav1.APIResource{{Name: "widgets", Kind: "Widget", Namespaced: true}},
+			},
+			"bar.example.com/v1": {
+				GroupVersion: "bar.example.com/v1",
+				APIResources: []metav1.APIResource{{Name: "widgets", Kind: "Widget", Namespaced: true}},
+			},
+		},
+	}
+
+	finder, err := NewFinder(&rest.Config{Host: "https://localhost:8443"}, WithRESTMapper(func(discovery.CachedDiscoveryInterface) meta.RESTMapper {
+		return lazyrestmapper.New(fake)
+	}))
+	if err != nil {
+		t.Fatalf("failed to create finder: %v", err)
+	}
+
+	_, _, err = finder.Get(context.Background(), "widgets", "default")
+
+	var ambiguous *AmbiguousResourceError
+	if !errors.As(err, &ambiguous) {
+		t.Fatalf("expected *AmbiguousResourceError, got %T: %v", err, err)
+	}
+	if len(ambiguous.Candidates) != 2 {
+		t.Errorf("expected 2 candidates, got %d", len(ambiguous.Candidates))
+	}
+}
+
+// fakeDiscoveryClient is a minimal discovery.CachedDiscoveryInterface backing the real
+// lazyrestmapper.Mapper in TestFindGVR_AmbiguousPluralResourceName, so that test exercises
+// the production REST mapper instead of fakeRESTMapper's hardcoded responses.
+type fakeDiscoveryClient struct {
+	discovery.CachedDiscoveryInterface
+
+	groups    *metav1.APIGroupList
+	resources map[string]*metav1.APIResourceList
+}
+
+func (f *fakeDiscoveryClient) ServerGroups() (*metav1.APIGroupList, error) {
+	return f.groups, nil
+}
+
+func (f *fakeDiscoveryClient) ServerResourcesForGroupVersion(groupVersion string) (*metav1.APIResourceList, error) {
+	list, ok := f.resources[groupVersion]
+	if !ok {
+		return nil, fmt.Errorf("no resources for %s", groupVersion)
+	}
+	return list, nil
+}
+
+func TestFindGVR_ResourceDotGroupDisambiguates(t *testing.T) {
+	mapper := &fakeRESTMapper{
+		mappings: map[string][]*meta.RESTMapping{
+			"Widget": {
+				{Resource: schema.GroupVersionResource{Group: "appsgroup", Version: "v1", Resource: "widgets"}},
+				{Resource: schema.GroupVersionResource{Group: "othergroup", Version: "v1", Resource: "widgets"}},
+			},
+		},
+	}
+
+	finder, err := NewFinder(&rest.Config{Host: "https://localhost:8443"}, WithRESTMapper(func(discovery.CachedDiscoveryInterface) meta.RESTMapper {
+		return mapper
+	}))
+	if err != nil {
+		t.Fatalf("failed to create finder: %v", err)
+	}
+
+	gvr, err := finder.findGVR("Widget.appsgroup")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gvr.Group != "appsgroup" {
+		t.Errorf("expected group %q, got %q", "appsgroup", gvr.Group)
+	}
+}
+
+func TestGetWithGVK(t *testing.T) {
+	mapper := &fakeRESTMapper{
+		mappings: map[string][]*meta.RESTMapping{
+			"Widget": {
+				{Resource: schema.GroupVersionResource{Group: "appsgroup", Version: "v1", Resource: "widgets"}},
+			},
+		},
+	}
+
+	finder, err := NewFinder(&rest.Config{Host: "https://localhost:8443"}, WithRESTMapper(func(discovery.CachedDiscoveryInterface) meta.RESTMapper {
+		return mapper
+	}))
+	if err != nil {
+		t.Fatalf("failed to create finder: %v", err)
+	}
+
+	gvr, err := finder.findGVRForGVK(schema.GroupVersionKind{Group: "appsgroup", Version: "v1", Kind: "Widget"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gvr.Resource != "widgets" {
+		t.Errorf("expected resource %q, got %q", "widgets", gvr.Resource)
+	}
+}
+
+// fakeRESTMapper is a minimal meta.RESTMapper for exercising findGVR's ambiguity
+// handling without a live cluster. Only RESTMappings (and RESTMapping, which delegates to
+// it) is actually backed by test data; KindFor, KindsFor, ResourceFor and ResourcesFor
+// return errors so findGVR falls through to it.
+type fakeRESTMapper struct {
+	mappings map[string][]*meta.RESTMapping
+}
+
+func (f *fakeRESTMapper) KindFor(schema.GroupVersionResource) (schema.GroupVersionKind, error) {
+	return schema.GroupVersionKind{}, fmt.Errorf("not implemented")
+}
+
+func (f *fakeRESTMapper) KindsFor(schema.GroupVersionResource) ([]schema.GroupVersionKind, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (f *fakeRESTMapper) ResourceFor(schema.GroupVersionResource) (schema.GroupVersionResource, error) {
+	return schema.GroupVersionResource{}, fmt.Errorf("not found")
+}
+
+func (f *fakeRESTMapper) ResourcesFor(schema.GroupVersionResource) ([]schema.GroupVersionResource, error) {
+	return nil, fmt.Errorf("not found")
+}
+
+func (f *fakeRESTMapper) RESTMapping(gk schema.GroupKind, versions ...string) (*meta.RESTMapping, error) {
+	mappings, err := f.RESTMappings(gk, versions...)
+	if err != nil {
+		return nil, err
+	}
+	return mappings[0], nil
+}
+
+func (f *fakeRESTMapper) RESTMappings(gk schema.GroupKind, versions ...string) ([]*meta.RESTMapping, error) {
+	mappings, ok := f.mappings[gk.Kind]
+	if !ok {
+		return nil, &meta.NoKindMatchError{GroupKind: gk}
+	}
+	if gk.Group == "" {
+		return mappings, nil
+	}
+
+	var filtered []*meta.RESTMapping
+	for _, m := range mappings {
+		if m.Resource.Group == gk.Group {
+			filtered = append(filtered, m)
+		}
+	}
+	if len(filtered) == 0 {
+		return nil, &meta.NoKindMatchError{GroupKind: gk}
+	}
+	return filtered, nil
+}
+
+func (f *fakeRESTMapper) ResourceSingularizer(resource string) (string, error) {
+	return resource, nil
+}
+
 // Helper functions for testing
 
 func splitResourceName(resourceName string) []string {
@@ -0,0 +1,127 @@
+package kubeget
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/discovery"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// defaultGetAllConcurrency bounds how many resource types GetAll lists at once.
+const defaultGetAllConcurrency = 8
+
+// AllFilter narrows down which API groups and resources Finder.GetAll sweeps.
+type AllFilter struct {
+	IncludeGroups []string
+	ExcludeGroups []string
+	LabelSelector string
+	OnlyBuiltins  bool
+}
+
+// ResourceBatch is a single resolved resource type's listing from GetAll.
+type ResourceBatch struct {
+	GVR  schema.GroupVersionResource
+	List *unstructured.UnstructuredList
+}
+
+// GetAll enumerates every API-server-preferred resource the way kubectl's "get all"
+// does, optionally scoped to namespace, and lists each one concurrently. A single
+// unreachable or erroring group doesn't abort the sweep: every partial error is
+// collected and returned together alongside whatever batches did succeed.
+func (f *Finder) GetAll(ctx context.Context, namespace string, filter AllFilter) ([]ResourceBatch, error) {
+	apiResourceLists, discoveryErr := f.discoveryClient.ServerPreferredResources()
+	if discoveryErr != nil && len(apiResourceLists) == 0 {
+		return nil, fmt.Errorf("failed to list preferred resources: %w", discoveryErr)
+	}
+
+	apiResourceLists = discovery.FilteredBy(discovery.SupportsAllVerbs{Verbs: []string{"list"}}, apiResourceLists)
+	gvrs := gvrsForGetAll(apiResourceLists, namespace, filter)
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(defaultGetAllConcurrency)
+
+	var mu sync.Mutex
+	batches := make([]ResourceBatch, 0, len(gvrs))
+	var errs []error
+	if discoveryErr != nil {
+		errs = append(errs, discoveryErr)
+	}
+
+	for _, gvr := range gvrs {
+		gvr := gvr
+		g.Go(func() error {
+			list, err := f.resourceInterface(gvr, namespace).List(gctx, metav1.ListOptions{LabelSelector: filter.LabelSelector})
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to list %s: %w", gvr, err))
+				return nil
+			}
+			batches = append(batches, ResourceBatch{GVR: gvr, List: list})
+			return nil
+		})
+	}
+	_ = g.Wait() // per-resource errors are collected above, not propagated here
+
+	return batches, utilerrors.NewAggregate(errs)
+}
+
+// gvrsForGetAll resolves the set of GVRs GetAll should list: every namespaced (or, when
+// namespace is empty, every) non-subresource resource from apiResourceLists that passes
+// filter.
+func gvrsForGetAll(apiResourceLists []*metav1.APIResourceList, namespace string, filter AllFilter) []schema.GroupVersionResource {
+	include := toGroupSet(filter.IncludeGroups)
+	exclude := toGroupSet(filter.ExcludeGroups)
+
+	var gvrs []schema.GroupVersionResource
+	for _, apiResourceList := range apiResourceLists {
+		if apiResourceList == nil {
+			continue
+		}
+		gv, err := schema.ParseGroupVersion(apiResourceList.GroupVersion)
+		if err != nil {
+			continue
+		}
+
+		if len(include) > 0 && !include[gv.Group] {
+			continue
+		}
+		if exclude[gv.Group] {
+			continue
+		}
+		// CRD groups are conventionally a DNS subdomain (e.g. "example.com"), while
+		// built-in groups are a single word (e.g. "apps", "batch", or the empty core
+		// group) - filter on that instead of maintaining an explicit allowlist.
+		if filter.OnlyBuiltins && strings.Contains(gv.Group, ".") {
+			continue
+		}
+
+		for _, apiResource := range apiResourceList.APIResources {
+			if strings.Contains(apiResource.Name, "/") {
+				continue // skip subresources such as pods/status
+			}
+			if namespace != "" && !apiResource.Namespaced {
+				continue
+			}
+			gvrs = append(gvrs, gv.WithResource(apiResource.Name))
+		}
+	}
+	return gvrs
+}
+
+func toGroupSet(groups []string) map[string]bool {
+	set := make(map[string]bool, len(groups))
+	for _, group := range groups {
+		set[group] = true
+	}
+	return set
+}
@@ -0,0 +1,80 @@
+package kubeget
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGvrsForGetAll(t *testing.T) {
+	apiResourceLists := []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "pods", Namespaced: true},
+				{Name: "nodes", Namespaced: false},
+				{Name: "pods/status", Namespaced: true},
+			},
+		},
+		{
+			GroupVersion: "widgets.example.com/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "widgets", Namespaced: true},
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		namespace string
+		filter    AllFilter
+		expected  []string // "group/resource"
+	}{
+		{
+			name:      "no namespace includes cluster-scoped resources",
+			namespace: "",
+			filter:    AllFilter{},
+			expected:  []string{"/pods", "/nodes", "widgets.example.com/widgets"},
+		},
+		{
+			name:      "namespace scoped drops cluster-scoped resources",
+			namespace: "default",
+			filter:    AllFilter{},
+			expected:  []string{"/pods", "widgets.example.com/widgets"},
+		},
+		{
+			name:      "only builtins drops dotted CRD groups",
+			namespace: "default",
+			filter:    AllFilter{OnlyBuiltins: true},
+			expected:  []string{"/pods"},
+		},
+		{
+			name:      "exclude groups",
+			namespace: "default",
+			filter:    AllFilter{ExcludeGroups: []string{"widgets.example.com"}},
+			expected:  []string{"/pods"},
+		},
+		{
+			name:      "include groups",
+			namespace: "default",
+			filter:    AllFilter{IncludeGroups: []string{"widgets.example.com"}},
+			expected:  []string{"widgets.example.com/widgets"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gvrs := gvrsForGetAll(apiResourceLists, tt.namespace, tt.filter)
+
+			if len(gvrs) != len(tt.expected) {
+				t.Fatalf("expected %d GVRs, got %d: %v", len(tt.expected), len(gvrs), gvrs)
+			}
+			for i, want := range tt.expected {
+				got := gvrs[i].Group + "/" + gvrs[i].Resource
+				if got != want {
+					t.Errorf("gvr %d: expected %q, got %q", i, want, got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,389 @@
+// Package lazyrestmapper provides a meta.RESTMapper that resolves a qualified GVK/GVR
+// (Group and Version both given) without pulling the entire API surface on a cache miss,
+// unlike restmapper.NewDeferredDiscoveryRESTMapper. An unqualified, bare-name lookup
+// still has to fetch every group-version's resources, since ruling out an ambiguous
+// match (the same resource or Kind name exposed by more than one group) requires
+// checking all of them.
+package lazyrestmapper
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+)
+
+// Mapper is a meta.RESTMapper that only queries ServerGroups() once, then fetches
+// ServerResourcesForGroupVersion(gv) on demand the first time a group-version is
+// requested. A qualified lookup (Group and Version both given) therefore only ever
+// touches that one discovery document. A bare-name lookup has to fetch every candidate
+// group-version's document, because only by checking all of them can it tell an
+// unambiguous match from a resource or Kind name that collides across two CRDs. Results
+// are cached per group-version behind a sync.RWMutex until Reset is called, so repeat
+// lookups - ambiguous or not - are free after the first.
+type Mapper struct {
+	discoveryClient discovery.CachedDiscoveryInterface
+
+	mu      sync.RWMutex
+	groups  []schema.GroupVersion // nil until ServerGroups has been fetched
+	mappers map[schema.GroupVersion]*meta.DefaultRESTMapper
+}
+
+// New creates a Mapper backed by discoveryClient. Nothing is fetched until the first
+// lookup.
+func New(discoveryClient discovery.CachedDiscoveryInterface) *Mapper {
+	return &Mapper{discoveryClient: discoveryClient}
+}
+
+// Reset drops every cached group-version mapper along with the cached group list, so
+// the next lookup re-queries ServerGroups() and re-fetches each group-version's
+// resources as they're needed again.
+func (m *Mapper) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.groups = nil
+	m.mappers = nil
+}
+
+// KindFor implements meta.RESTMapper. It returns a *meta.AmbiguousResourceError,
+// carrying the matches KindsFor found before it stopped scanning (at least two), when
+// more than one group-version exposes resource - e.g. two
+// CRDs installing the same plural resource name in different groups.
+func (m *Mapper) KindFor(resource schema.GroupVersionResource) (schema.GroupVersionKind, error) {
+	kinds, err := m.KindsFor(resource)
+	if err != nil {
+		return schema.GroupVersionKind{}, err
+	}
+	if len(kinds) > 1 {
+		return schema.GroupVersionKind{}, &meta.AmbiguousResourceError{PartialResource: resource, MatchingKinds: kinds}
+	}
+	return kinds[0], nil
+}
+
+// KindsFor implements meta.RESTMapper. A qualified lookup (candidateGroupVersions
+// returns a single group-version) costs one fetch, same as before. A bare-name lookup
+// keeps scanning candidate group-versions past the first match instead of stopping
+// there, so a resource name that collides across two groups is reported as
+// ambiguous rather than silently resolved to whichever group happened to be checked
+// first; it stops once a second match is found, so further matches in group-versions
+// not yet scanned are not returned.
+func (m *Mapper) KindsFor(resource schema.GroupVersionResource) ([]schema.GroupVersionKind, error) {
+	gvs, err := m.candidateGroupVersions(resource.GroupVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	var found []schema.GroupVersionKind
+	for _, gv := range gvs {
+		mapper, err := m.mapperFor(gv)
+		if err != nil {
+			continue
+		}
+		kinds, err := mapper.KindsFor(schema.GroupVersionResource{Group: gv.Group, Version: gv.Version, Resource: resource.Resource})
+		if err != nil || len(kinds) == 0 {
+			continue
+		}
+		found = append(found, kinds...)
+		if len(found) >= 2 {
+			break
+		}
+	}
+
+	if len(found) == 0 {
+		return nil, &meta.NoResourceMatchError{PartialResource: resource}
+	}
+	return found, nil
+}
+
+// ResourceFor implements meta.RESTMapper. It returns a *meta.AmbiguousResourceError,
+// carrying the matches ResourcesFor found before it stopped scanning (at least two),
+// when more than one group-version exposes input - e.g. two CRDs
+// installing the same plural resource name in different groups.
+func (m *Mapper) ResourceFor(input schema.GroupVersionResource) (schema.GroupVersionResource, error) {
+	resources, err := m.ResourcesFor(input)
+	if err != nil {
+		return schema.GroupVersionResource{}, err
+	}
+	if len(resources) > 1 {
+		return schema.GroupVersionResource{}, &meta.AmbiguousResourceError{PartialResource: input, MatchingResources: resources}
+	}
+	return resources[0], nil
+}
+
+// ResourcesFor implements meta.RESTMapper. A qualified lookup (candidateGroupVersions
+// returns a single group-version) costs one fetch, same as before. A bare-name lookup
+// keeps scanning candidate group-versions past the first match instead of stopping
+// there, so a resource name that collides across two groups is reported as
+// ambiguous rather than silently resolved to whichever group happened to be checked
+// first; it stops once a second match is found, so further matches in group-versions
+// not yet scanned are not returned.
+func (m *Mapper) ResourcesFor(input schema.GroupVersionResource) ([]schema.GroupVersionResource, error) {
+	gvs, err := m.candidateGroupVersions(input.GroupVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	var found []schema.GroupVersionResource
+	for _, gv := range gvs {
+		mapper, err := m.mapperFor(gv)
+		if err != nil {
+			continue
+		}
+		resources, err := mapper.ResourcesFor(schema.GroupVersionResource{Group: gv.Group, Version: gv.Version, Resource: input.Resource})
+		if err != nil || len(resources) == 0 {
+			continue
+		}
+		found = append(found, resources...)
+		if len(found) >= 2 {
+			break
+		}
+	}
+
+	if len(found) == 0 {
+		return nil, &meta.NoResourceMatchError{PartialResource: input}
+	}
+	return found, nil
+}
+
+// RESTMapping implements meta.RESTMapper.
+func (m *Mapper) RESTMapping(gk schema.GroupKind, versions ...string) (*meta.RESTMapping, error) {
+	mappings, err := m.RESTMappings(gk, versions...)
+	if err != nil {
+		return nil, err
+	}
+	return mappings[0], nil
+}
+
+// RESTMappings implements meta.RESTMapper.
+func (m *Mapper) RESTMappings(gk schema.GroupKind, versions ...string) ([]*meta.RESTMapping, error) {
+	gvs, err := m.groupVersionsFor(gk.Group, versions)
+	if err != nil {
+		return nil, err
+	}
+
+	var mappings []*meta.RESTMapping
+	for _, gv := range gvs {
+		mapper, err := m.mapperFor(gv)
+		if err != nil {
+			continue
+		}
+		found, err := mapper.RESTMappings(gk, gv.Version)
+		if err != nil {
+			continue
+		}
+		mappings = append(mappings, found...)
+	}
+
+	if len(mappings) == 0 {
+		return nil, &meta.NoKindMatchError{GroupKind: gk, SearchedVersions: versions}
+	}
+	return mappings, nil
+}
+
+// ResourceSingularizer implements meta.RESTMapper. It only consults group-versions
+// whose resources have already been fetched, since the plural/singular mapping isn't
+// otherwise known without a group-version to look it up in; unknown resource types are
+// returned unchanged, matching meta.DefaultRESTMapper's own fallback behavior.
+func (m *Mapper) ResourceSingularizer(resourceType string) (string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for _, mapper := range m.mappers {
+		if singular, err := mapper.ResourceSingularizer(resourceType); err == nil && singular != resourceType {
+			return singular, nil
+		}
+	}
+	return resourceType, nil
+}
+
+// serverGroups returns every group-version the server advertises, fetching and caching
+// them via ServerGroups() on first use.
+func (m *Mapper) serverGroups() ([]schema.GroupVersion, error) {
+	m.mu.RLock()
+	if m.groups != nil {
+		groups := m.groups
+		m.mu.RUnlock()
+		return groups, nil
+	}
+	m.mu.RUnlock()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.groups != nil {
+		return m.groups, nil
+	}
+
+	if m.discoveryClient == nil {
+		return nil, fmt.Errorf("lazy REST mapper has no discovery client")
+	}
+
+	apiGroupList, err := m.discoveryClient.ServerGroups()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list server groups: %w", err)
+	}
+
+	var groups []schema.GroupVersion
+	for _, group := range apiGroupList.Groups {
+		for _, version := range group.Versions {
+			gv, err := schema.ParseGroupVersion(version.GroupVersion)
+			if err != nil {
+				continue
+			}
+			groups = append(groups, gv)
+		}
+	}
+
+	m.groups = groups
+	if m.mappers == nil {
+		m.mappers = make(map[schema.GroupVersion]*meta.DefaultRESTMapper)
+	}
+
+	return groups, nil
+}
+
+// mapperFor returns the cached per-group-version mapper for gv, fetching
+// ServerResourcesForGroupVersion(gv) and building it on first use.
+func (m *Mapper) mapperFor(gv schema.GroupVersion) (*meta.DefaultRESTMapper, error) {
+	m.mu.RLock()
+	if mapper, ok := m.mappers[gv]; ok {
+		m.mu.RUnlock()
+		return mapper, nil
+	}
+	m.mu.RUnlock()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if mapper, ok := m.mappers[gv]; ok {
+		return mapper, nil
+	}
+
+	resourceList, err := m.discoveryClient.ServerResourcesForGroupVersion(gv.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resources for %s: %w", gv, err)
+	}
+
+	mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{gv})
+	for _, resource := range resourceList.APIResources {
+		if resource.Name == "" || strings.Contains(resource.Name, "/") {
+			continue // skip subresources such as pods/status
+		}
+
+		scope := meta.RESTScopeNamespace
+		if !resource.Namespaced {
+			scope = meta.RESTScopeRoot
+		}
+
+		plural := gv.WithResource(resource.Name)
+		singular := gv.WithResource(singularName(resource))
+		kind := gv.WithKind(resource.Kind)
+
+		mapper.AddSpecific(kind, plural, singular, scope)
+		for _, shortName := range resource.ShortNames {
+			mapper.AddSpecific(kind, gv.WithResource(shortName), singular, scope)
+		}
+	}
+
+	if m.mappers == nil {
+		m.mappers = make(map[schema.GroupVersion]*meta.DefaultRESTMapper)
+	}
+	m.mappers[gv] = mapper
+
+	return mapper, nil
+}
+
+func singularName(resource metav1.APIResource) string {
+	if resource.SingularName != "" {
+		return resource.SingularName
+	}
+	return resource.Name
+}
+
+// candidateGroupVersions returns the group-versions to search for a resource lookup:
+// just filter when both Group and Version are given, every version of that group when
+// only Group is given, or every known group-version when neither is given - ordered so
+// that ResourcesFor/KindsFor, which stop early once a second match turns a lookup
+// ambiguous, check the cheap and likely candidates before anything else.
+func (m *Mapper) candidateGroupVersions(filter schema.GroupVersion) ([]schema.GroupVersion, error) {
+	all, err := m.serverGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	if filter.Group != "" && filter.Version != "" {
+		return []schema.GroupVersion{filter}, nil
+	}
+
+	var candidates []schema.GroupVersion
+	for _, gv := range all {
+		if filter.Group != "" && gv.Group != filter.Group {
+			continue
+		}
+		candidates = append(candidates, gv)
+	}
+	return m.prioritize(candidates), nil
+}
+
+// prioritize reorders gvs so that group-versions whose resources are already cached
+// (free to consult) come first, then the core "v1" group-version (the overwhelmingly
+// common case for an unqualified lookup like "pods"), then everything else in server
+// order. An unambiguous bare-name lookup still has to scan every candidate to rule out a
+// second match elsewhere, but this ordering means it finds its (only) match on the first
+// or second fetch rather than at a random point in server order.
+func (m *Mapper) prioritize(gvs []schema.GroupVersion) []schema.GroupVersion {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var cached, core, rest []schema.GroupVersion
+	for _, gv := range gvs {
+		switch {
+		case m.mappers[gv] != nil:
+			cached = append(cached, gv)
+		case gv.Group == "" && gv.Version == "v1":
+			core = append(core, gv)
+		default:
+			rest = append(rest, gv)
+		}
+	}
+
+	ordered := make([]schema.GroupVersion, 0, len(gvs))
+	ordered = append(ordered, cached...)
+	ordered = append(ordered, core...)
+	ordered = append(ordered, rest...)
+	return ordered
+}
+
+// groupVersionsFor returns the group-versions to search for a kind lookup: the
+// explicitly requested versions of group if any were given, otherwise every version of
+// group that the server advertises.
+func (m *Mapper) groupVersionsFor(group string, versions []string) ([]schema.GroupVersion, error) {
+	var requested []string
+	for _, v := range versions {
+		if v != "" {
+			requested = append(requested, v)
+		}
+	}
+	if len(requested) > 0 {
+		gvs := make([]schema.GroupVersion, 0, len(requested))
+		for _, v := range requested {
+			gvs = append(gvs, schema.GroupVersion{Group: group, Version: v})
+		}
+		return gvs, nil
+	}
+
+	all, err := m.serverGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []schema.GroupVersion
+	for _, gv := range all {
+		if gv.Group == group {
+			candidates = append(candidates, gv)
+		}
+	}
+	return candidates, nil
+}
@@ -0,0 +1,148 @@
+package lazyrestmapper
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+)
+
+func TestMapper_NilDiscoveryClient(t *testing.T) {
+	mapper := New(nil)
+
+	if _, err := mapper.ResourceFor(schema.GroupVersionResource{Resource: "pods"}); err == nil {
+		t.Error("expected error when discovery client is nil, got none")
+	}
+}
+
+func TestMapper_Reset(t *testing.T) {
+	mapper := New(nil)
+
+	// Reset must be safe to call even before anything has ever been fetched.
+	mapper.Reset()
+
+	if mapper.groups != nil || mapper.mappers != nil {
+		t.Error("expected groups and mappers to be nil after Reset on an unused mapper")
+	}
+}
+
+func TestMapper_ResourceSingularizer_UnknownFallsBackToInput(t *testing.T) {
+	mapper := New(nil)
+
+	singular, err := mapper.ResourceSingularizer("widgets")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if singular != "widgets" {
+		t.Errorf("expected fallback to the input %q, got %q", "widgets", singular)
+	}
+}
+
+// TestMapper_ResourceFor_QualifiedLookupOnlyFetchesThatGroupVersion guards against a
+// qualified lookup (Group and Version both given) fetching any group-version besides the
+// one it names - that part of the lazy mapper's fetch-avoidance still holds even though,
+// per TestMapper_ResourceFor_AmbiguousAcrossGroups below, a bare-name lookup now has to
+// check every candidate to rule out ambiguity.
+func TestMapper_ResourceFor_QualifiedLookupOnlyFetchesThatGroupVersion(t *testing.T) {
+	fake := &fakeDiscoveryClient{
+		groups: &metav1.APIGroupList{Groups: []metav1.APIGroup{
+			{Versions: []metav1.GroupVersionForDiscovery{{GroupVersion: "v1", Version: "v1"}}},
+			{Name: "apps", Versions: []metav1.GroupVersionForDiscovery{{GroupVersion: "apps/v1", Version: "v1"}}},
+		}},
+		resources: map[string]*metav1.APIResourceList{
+			"v1": {
+				GroupVersion: "v1",
+				APIResources: []metav1.APIResource{{Name: "pods", Kind: "Pod", Namespaced: true}},
+			},
+			"apps/v1": {
+				GroupVersion: "apps/v1",
+				APIResources: []metav1.APIResource{{Name: "deployments", Kind: "Deployment", Namespaced: true}},
+			},
+		},
+	}
+
+	mapper := New(fake)
+
+	gvr, err := mapper.ResourceFor(schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gvr.Version != "v1" || gvr.Group != "apps" {
+		t.Errorf("expected apps/v1 deployments, got %s", gvr)
+	}
+
+	if len(fake.resourceCalls) != 1 || fake.resourceCalls[0] != "apps/v1" {
+		t.Errorf("expected exactly one ServerResourcesForGroupVersion call for %q, got %v", "apps/v1", fake.resourceCalls)
+	}
+}
+
+// TestMapper_ResourceFor_AmbiguousAcrossGroups guards against the lazy mapper silently
+// picking one of several colliding group-versions for a bare-name lookup, the way a
+// stop-at-first-match implementation would. Two distinct groups, "foo.example.com" and
+// "bar.example.com", both expose a "widgets" resource; ResourceFor must report this as
+// ambiguous instead of arbitrarily resolving to whichever group it checked first.
+func TestMapper_ResourceFor_AmbiguousAcrossGroups(t *testing.T) {
+	fake := &fakeDiscoveryClient{
+		groups: &metav1.APIGroupList{Groups: []metav1.APIGroup{
+			{Name: "foo.example.com", Versions: []metav1.GroupVersionForDiscovery{{GroupVersion: "foo.example.com/v1", Version: "v1"}}},
+			{Name: "bar.example.com", Versions: []metav1.GroupVersionForDiscovery{{GroupVersion: "bar.example.com/v1", Version: "v1"}}},
+		}},
+		resources: map[string]*metav1.APIResourceList{
+			"foo.example.com/v1": {
+				GroupVersion: "foo.example.com/v1",
+				APIResources: []metav1.APIResource{{Name: "widgets", Kind: "Widget", Namespaced: true}},
+			},
+			"bar.example.com/v1": {
+				GroupVersion: "bar.example.com/v1",
+				APIResources: []metav1.APIResource{{Name: "widgets", Kind: "Widget", Namespaced: true}},
+			},
+		},
+	}
+
+	mapper := New(fake)
+
+	_, err := mapper.ResourceFor(schema.GroupVersionResource{Resource: "widgets"})
+
+	var ambiguous *meta.AmbiguousResourceError
+	if !errors.As(err, &ambiguous) {
+		t.Fatalf("expected *meta.AmbiguousResourceError, got %T: %v", err, err)
+	}
+	if len(ambiguous.MatchingResources) != 2 {
+		t.Errorf("expected 2 matching resources, got %d: %v", len(ambiguous.MatchingResources), ambiguous.MatchingResources)
+	}
+}
+
+// fakeDiscoveryClient is a minimal discovery.CachedDiscoveryInterface for exercising the
+// lazy mapper's fetch pattern. Only ServerGroups and ServerResourcesForGroupVersion are
+// implemented; anything else panics via the nil embedded interface, which is fine since
+// the mapper never calls them.
+type fakeDiscoveryClient struct {
+	discovery.CachedDiscoveryInterface
+
+	groups    *metav1.APIGroupList
+	resources map[string]*metav1.APIResourceList
+
+	mu            sync.Mutex
+	resourceCalls []string
+}
+
+func (f *fakeDiscoveryClient) ServerGroups() (*metav1.APIGroupList, error) {
+	return f.groups, nil
+}
+
+func (f *fakeDiscoveryClient) ServerResourcesForGroupVersion(groupVersion string) (*metav1.APIResourceList, error) {
+	f.mu.Lock()
+	f.resourceCalls = append(f.resourceCalls, groupVersion)
+	f.mu.Unlock()
+
+	list, ok := f.resources[groupVersion]
+	if !ok {
+		return nil, fmt.Errorf("no resources for %s", groupVersion)
+	}
+	return list, nil
+}
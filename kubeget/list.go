@@ -0,0 +1,68 @@
+package kubeget
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ListOptions configures Finder.List's server-side filtering and pagination, mirroring
+// the fields of metav1.ListOptions that callers actually need.
+type ListOptions struct {
+	LabelSelector   string
+	FieldSelector   string
+	Limit           int64
+	Continue        string
+	ResourceVersion string
+}
+
+// ListResult is the result of a Finder.List call. Continue carries the API server's
+// pagination token, if more pages remain; pass it back as ListOptions.Continue to fetch
+// the next page.
+type ListResult struct {
+	GVR      schema.GroupVersionResource
+	List     *unstructured.UnstructuredList
+	Continue string
+}
+
+// GetByName resolves resourceName to a GVR and fetches the single named object, the way
+// Helm's lookup template function does, instead of listing everything and filtering
+// client-side.
+func (f *Finder) GetByName(ctx context.Context, resourceName, namespace, name string) (*unstructured.Unstructured, error) {
+	gvr, err := f.findGVR(resourceName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find resource %q: %w", resourceName, err)
+	}
+
+	obj, err := f.resourceInterface(gvr, namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resource %q %q: %w", resourceName, name, err)
+	}
+
+	return obj, nil
+}
+
+// List resolves resourceName to a GVR and lists it with server-side filtering and
+// pagination, unlike Get which always lists everything.
+func (f *Finder) List(ctx context.Context, resourceName, namespace string, opts ListOptions) (*ListResult, error) {
+	gvr, err := f.findGVR(resourceName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find resource %q: %w", resourceName, err)
+	}
+
+	list, err := f.resourceInterface(gvr, namespace).List(ctx, metav1.ListOptions{
+		LabelSelector:   opts.LabelSelector,
+		FieldSelector:   opts.FieldSelector,
+		Limit:           opts.Limit,
+		Continue:        opts.Continue,
+		ResourceVersion: opts.ResourceVersion,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resources: %w", err)
+	}
+
+	return &ListResult{GVR: gvr, List: list, Continue: list.GetContinue()}, nil
+}
@@ -0,0 +1,60 @@
+package kubeget
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/client-go/rest"
+)
+
+func TestGetByName_ErrorHandling(t *testing.T) {
+	tests := []struct {
+		name         string
+		resourceName string
+		errorSubstr  string
+	}{
+		{
+			name:         "empty resource name",
+			resourceName: "",
+			errorSubstr:  "failed to find resource",
+		},
+		{
+			name:         "invalid resource name",
+			resourceName: "nonexistentresource",
+			errorSubstr:  "failed to find resource",
+		},
+	}
+
+	finder, err := NewFinder(&rest.Config{Host: "https://localhost:8443"})
+	if err != nil {
+		t.Fatalf("failed to create finder: %v", err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := finder.GetByName(context.Background(), tt.resourceName, "default", "my-object")
+
+			if err == nil {
+				t.Fatal("expected error but got none")
+			}
+			if !containsString(err.Error(), tt.errorSubstr) {
+				t.Errorf("expected error to contain %q, got: %v", tt.errorSubstr, err)
+			}
+		})
+	}
+}
+
+func TestList_ErrorHandling(t *testing.T) {
+	finder, err := NewFinder(&rest.Config{Host: "https://localhost:8443"})
+	if err != nil {
+		t.Fatalf("failed to create finder: %v", err)
+	}
+
+	_, err = finder.List(context.Background(), "", "default", ListOptions{})
+	if err == nil {
+		t.Fatal("expected error but got none")
+	}
+	if !containsString(err.Error(), "failed to find resource") {
+		t.Errorf("expected error to contain %q, got: %v", "failed to find resource", err)
+	}
+}
@@ -0,0 +1,202 @@
+package kubeget
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+)
+
+// Event wraps a single watch notification for a resource.
+type Event struct {
+	Type   watch.EventType
+	Object *unstructured.Unstructured
+}
+
+// WatchOptions controls how Watch selects, resyncs, and streams resources, mirroring
+// `kubectl get -w` plus the resync behavior of a client-go informer.
+type WatchOptions struct {
+	// LabelSelector restricts the watched items, as in metav1.ListOptions.
+	LabelSelector string
+	// FieldSelector restricts the watched items, as in metav1.ListOptions.
+	FieldSelector string
+	// ResyncPeriod, if non-zero, periodically re-lists the resource and re-emits every
+	// item as an Added event, the way a client-go informer's resync does.
+	ResyncPeriod time.Duration
+	// IncludeInitialList replays the current state as Added events before streaming
+	// subsequent changes, instead of starting the caller off with an empty view.
+	IncludeInitialList bool
+}
+
+// Watch streams ADDED/MODIFIED/DELETED events for a resource, resolving the GVR the same
+// way Get does. The returned channel is closed when ctx is done or the watch cannot be
+// restarted. If the server reports the watch's resourceVersion has expired, Watch
+// transparently re-lists and re-establishes the watch from there. An error that ends the
+// watch (a failed list, a failed Watch call, or a watch error event other than expiry) is
+// reported on Errors() before the channel is closed.
+func (f *Finder) Watch(ctx context.Context, resourceName, namespace string, opts WatchOptions) (<-chan Event, error) {
+	gvr, err := f.findGVR(resourceName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find resource %q: %w", resourceName, err)
+	}
+
+	resourceInterface := f.resourceInterface(gvr, namespace)
+	events := make(chan Event)
+
+	go func() {
+		defer close(events)
+
+		resourceVersion := ""
+		if opts.IncludeInitialList {
+			rv, ok := f.emitInitialList(ctx, resourceInterface, opts, events)
+			if !ok {
+				return
+			}
+			resourceVersion = rv
+		}
+
+		f.streamWatch(ctx, resourceInterface, opts, resourceVersion, events)
+	}()
+
+	return events, nil
+}
+
+// Errors returns the channel that errors ending a watch are reported on. It is shared
+// across every Watch call on this Finder and is created lazily on first use.
+func (f *Finder) Errors() <-chan error {
+	f.ensureWatchErrs()
+	return f.watchErrs
+}
+
+func (f *Finder) ensureWatchErrs() {
+	f.watchErrsOnce.Do(func() {
+		f.watchErrs = make(chan error, 16)
+	})
+}
+
+func (f *Finder) reportWatchErr(err error) {
+	f.ensureWatchErrs()
+	select {
+	case f.watchErrs <- err:
+	default: // drop rather than block a watch loop on a slow or absent reader
+	}
+}
+
+// emitInitialList replays the resource's current state as Added events, returning the
+// resourceVersion to resume watching from and whether the caller should continue.
+func (f *Finder) emitInitialList(ctx context.Context, resourceInterface dynamic.ResourceInterface, opts WatchOptions, events chan<- Event) (string, bool) {
+	list, err := resourceInterface.List(ctx, metav1.ListOptions{
+		LabelSelector: opts.LabelSelector,
+		FieldSelector: opts.FieldSelector,
+	})
+	if err != nil {
+		f.reportWatchErr(fmt.Errorf("failed to list initial state: %w", err))
+		return "", false
+	}
+
+	for i := range list.Items {
+		select {
+		case events <- Event{Type: watch.Added, Object: &list.Items[i]}:
+		case <-ctx.Done():
+			return "", false
+		}
+	}
+
+	return list.GetResourceVersion(), true
+}
+
+// streamWatch establishes a watch from resourceVersion and keeps re-establishing it,
+// re-listing whenever the server reports the resourceVersion has expired, until ctx is
+// done or a fatal error occurs. If opts.ResyncPeriod is set, it also re-lists and
+// re-emits the current state on that interval, the way an informer's resync does.
+func (f *Finder) streamWatch(ctx context.Context, resourceInterface dynamic.ResourceInterface, opts WatchOptions, resourceVersion string, events chan<- Event) {
+	var resyncTimer *time.Timer
+	if opts.ResyncPeriod > 0 {
+		resyncTimer = time.NewTimer(opts.ResyncPeriod)
+		defer resyncTimer.Stop()
+	}
+
+	for {
+		watcher, err := resourceInterface.Watch(ctx, metav1.ListOptions{
+			LabelSelector:   opts.LabelSelector,
+			FieldSelector:   opts.FieldSelector,
+			ResourceVersion: resourceVersion,
+		})
+		if err != nil {
+			f.reportWatchErr(fmt.Errorf("failed to start watch: %w", err))
+			return
+		}
+
+		var resyncCh <-chan time.Time
+		if resyncTimer != nil {
+			resyncCh = resyncTimer.C
+		}
+
+		newResourceVersion, resync, ok := f.drainWatch(ctx, watcher, events, resyncCh)
+		if !ok {
+			return
+		}
+		resourceVersion = newResourceVersion
+
+		if resync {
+			rv, ok := f.emitInitialList(ctx, resourceInterface, opts, events)
+			if !ok {
+				return
+			}
+			resourceVersion = rv
+			if resyncTimer != nil {
+				resyncTimer.Reset(opts.ResyncPeriod)
+			}
+		}
+	}
+}
+
+// drainWatch forwards events from watcher to events until the watch ends, the resync
+// timer fires, or ctx is done. It returns the last observed resourceVersion, whether a
+// resync was requested, and whether the caller should keep watching at all.
+func (f *Finder) drainWatch(ctx context.Context, watcher watch.Interface, events chan<- Event, resyncCh <-chan time.Time) (string, bool, bool) {
+	defer watcher.Stop()
+
+	lastResourceVersion := ""
+	for {
+		select {
+		case <-ctx.Done():
+			return "", false, false
+		case <-resyncCh:
+			return lastResourceVersion, true, true
+		case result, ok := <-watcher.ResultChan():
+			if !ok {
+				return lastResourceVersion, false, true
+			}
+
+			if result.Type == watch.Error {
+				if status, ok := result.Object.(*metav1.Status); ok && apierrors.IsResourceExpired(&apierrors.StatusError{ErrStatus: *status}) {
+					// The resourceVersion we'd otherwise resume from is exactly what the
+					// server just rejected - re-list instead of reopening Watch with it
+					// again, which would just repeat the same 410 forever.
+					return "", true, true
+				}
+				f.reportWatchErr(fmt.Errorf("watch error: %v", result.Object))
+				return "", false, false
+			}
+
+			obj, ok := result.Object.(*unstructured.Unstructured)
+			if !ok {
+				continue
+			}
+
+			lastResourceVersion = obj.GetResourceVersion()
+
+			select {
+			case events <- Event{Type: result.Type, Object: obj}:
+			case <-ctx.Done():
+				return "", false, false
+			}
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package gokubeget
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"k8s.io/client-go/rest"
+)
+
+// defaultDiscoveryCacheTTL is how long a cached discovery document is considered fresh
+// before it is re-fetched from the API server, matching kubectl's own default.
+const defaultDiscoveryCacheTTL = 10 * time.Minute
+
+// Option configures a KubeGet created by NewKubeGet.
+type Option func(*kubeGetOptions)
+
+type kubeGetOptions struct {
+	discoveryCacheDir string
+	discoveryCacheTTL time.Duration
+}
+
+func defaultKubeGetOptions(config *rest.Config) kubeGetOptions {
+	return kubeGetOptions{
+		discoveryCacheDir: defaultDiscoveryCacheDir(config),
+		discoveryCacheTTL: defaultDiscoveryCacheTTL,
+	}
+}
+
+// WithDiscoveryCacheDir stores the on-disk discovery cache under dir instead of the
+// default $XDG_CACHE_HOME/go-kube-get/discovery/<host>/<user>.
+func WithDiscoveryCacheDir(dir string) Option {
+	return func(o *kubeGetOptions) { o.discoveryCacheDir = dir }
+}
+
+// WithDiscoveryCacheTTL overrides how long a cached discovery document is considered
+// fresh. The default is defaultDiscoveryCacheTTL.
+func WithDiscoveryCacheTTL(ttl time.Duration) Option {
+	return func(o *kubeGetOptions) { o.discoveryCacheTTL = ttl }
+}
+
+// defaultDiscoveryCacheDir returns $XDG_CACHE_HOME/go-kube-get/discovery/<host>/<user>,
+// falling back to ~/.cache when XDG_CACHE_HOME is unset. disk.CachedDiscoveryClient's own
+// doc comment requires the directory to be unique per host:port, and keying it by the
+// authenticated user too keeps two kubeconfig identities pointed at the same cluster from
+// reading and writing each other's cached discovery documents - which matters once
+// MultiKubeGet fans out across clusters/contexts concurrently.
+func defaultDiscoveryCacheDir(config *rest.Config) string {
+	cacheHome := os.Getenv("XDG_CACHE_HOME")
+	if cacheHome == "" {
+		if home, err := os.UserHomeDir(); err == nil {
+			cacheHome = filepath.Join(home, ".cache")
+		}
+	}
+	return filepath.Join(cacheHome, "go-kube-get", "discovery", sanitizeForPath(config.Host), cacheUserFingerprint(config))
+}
+
+// pathUnsafeReplacer strips URL schemes and replaces the characters a host:port isn't
+// safe to use as a single path segment with.
+var pathUnsafeReplacer = strings.NewReplacer("https://", "", "http://", "", ":", "_", "/", "_")
+
+func sanitizeForPath(s string) string {
+	return pathUnsafeReplacer.Replace(s)
+}
+
+// cacheUserFingerprint identifies the identity config authenticates as. Credential
+// material is hashed rather than used verbatim so it never ends up readable in a cache
+// directory name; configs carrying no credentials at all (e.g. anonymous auth) share a
+// single bucket.
+func cacheUserFingerprint(config *rest.Config) string {
+	h := sha256.New()
+	io.WriteString(h, config.Username)
+	io.WriteString(h, config.BearerToken)
+	io.WriteString(h, config.BearerTokenFile)
+	h.Write(config.TLSClientConfig.CertData)
+	io.WriteString(h, config.TLSClientConfig.CertFile)
+	return hex.EncodeToString(h.Sum(nil))[:12]
+}
@@ -0,0 +1,57 @@
+package gokubeget
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/client-go/rest"
+)
+
+func TestDefaultKubeGetOptions(t *testing.T) {
+	opts := defaultKubeGetOptions(&rest.Config{Host: "https://cluster-a.example.com:6443"})
+
+	if opts.discoveryCacheTTL != defaultDiscoveryCacheTTL {
+		t.Errorf("expected default TTL %v, got %v", defaultDiscoveryCacheTTL, opts.discoveryCacheTTL)
+	}
+	if opts.discoveryCacheDir == "" {
+		t.Error("expected a non-empty default discovery cache dir")
+	}
+}
+
+func TestWithDiscoveryCacheOptions(t *testing.T) {
+	opts := defaultKubeGetOptions(&rest.Config{Host: "https://cluster-a.example.com:6443"})
+
+	WithDiscoveryCacheDir("/tmp/custom-cache")(&opts)
+	WithDiscoveryCacheTTL(42 * time.Second)(&opts)
+
+	if opts.discoveryCacheDir != "/tmp/custom-cache" {
+		t.Errorf("expected discoveryCacheDir %q, got %q", "/tmp/custom-cache", opts.discoveryCacheDir)
+	}
+	if opts.discoveryCacheTTL != 42*time.Second {
+		t.Errorf("expected discoveryCacheTTL %v, got %v", 42*time.Second, opts.discoveryCacheTTL)
+	}
+}
+
+func TestDefaultDiscoveryCacheDir_KeyedByHost(t *testing.T) {
+	dirA := defaultDiscoveryCacheDir(&rest.Config{Host: "https://cluster-a.example.com:6443"})
+	dirB := defaultDiscoveryCacheDir(&rest.Config{Host: "https://cluster-b.example.com:6443"})
+
+	if dirA == dirB {
+		t.Fatalf("expected different clusters to get different cache dirs, both got %q", dirA)
+	}
+	if !strings.Contains(dirA, "cluster-a.example.com_6443") {
+		t.Errorf("expected cache dir to contain the sanitized host, got %q", dirA)
+	}
+}
+
+func TestDefaultDiscoveryCacheDir_KeyedByUser(t *testing.T) {
+	host := "https://cluster-a.example.com:6443"
+
+	dirAlice := defaultDiscoveryCacheDir(&rest.Config{Host: host, Username: "alice"})
+	dirBob := defaultDiscoveryCacheDir(&rest.Config{Host: host, Username: "bob"})
+
+	if dirAlice == dirBob {
+		t.Fatalf("expected different users on the same cluster to get different cache dirs, both got %q", dirAlice)
+	}
+}
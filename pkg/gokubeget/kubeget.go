@@ -9,8 +9,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/client-go/discovery"
-	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/discovery/cached/disk"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/restmapper"
@@ -18,25 +19,34 @@ import (
 
 // KubeGet provides a kubectl get-like interface for fetching Kubernetes resources
 type KubeGet struct {
-	restMapper      meta.RESTMapper
-	dynamicClient   dynamic.Interface
-	discoveryClient discovery.CachedDiscoveryInterface
+	restMapper       meta.RESTMapper
+	dynamicClient    dynamic.Interface
+	discoveryClient  discovery.CachedDiscoveryInterface
+	shortcutExpander *ShortcutExpander
 }
 
-// NewKubeGet creates a new KubeGet instance using the provided Kubernetes configuration
-func NewKubeGet(config *rest.Config) (*KubeGet, error) {
+// NewKubeGet creates a new KubeGet instance using the provided Kubernetes configuration.
+// Discovery results (API groups, resources, and the REST mapping built from them) are
+// cached on disk so repeated invocations of a short-lived process, such as this
+// package's CLI, skip the full discovery round-trip. Use WithDiscoveryCacheDir and
+// WithDiscoveryCacheTTL to override where the cache lives and how long it stays fresh.
+func NewKubeGet(config *rest.Config, opts ...Option) (*KubeGet, error) {
 	if config == nil {
 		return nil, fmt.Errorf("config cannot be nil")
 	}
 
-	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
+	options := defaultKubeGetOptions(config)
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	// httpCacheDir is left empty: we only need to cache the discovery documents
+	// themselves, not the underlying HTTP responses.
+	cachedClient, err := disk.NewCachedDiscoveryClientForConfig(config, options.discoveryCacheDir, "", options.discoveryCacheTTL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create discovery client: %w", err)
 	}
 
-	// Wrap discovery client with memory cache for efficient resource discovery
-	cachedClient := memory.NewMemCacheClient(discoveryClient)
-
 	restMapper := restmapper.NewDeferredDiscoveryRESTMapper(cachedClient)
 
 	dynamicClient, err := dynamic.NewForConfig(config)
@@ -45,28 +55,130 @@ func NewKubeGet(config *rest.Config) (*KubeGet, error) {
 	}
 
 	return &KubeGet{
-		restMapper:      restMapper,
-		dynamicClient:   dynamicClient,
-		discoveryClient: cachedClient,
+		restMapper:       restMapper,
+		dynamicClient:    dynamicClient,
+		discoveryClient:  cachedClient,
+		shortcutExpander: NewShortcutExpander(cachedClient),
 	}, nil
 }
 
+// Invalidate drops every cached discovery result (the on-disk documents, the REST
+// mapper built from them, and the shortcut index), forcing the next lookup to hit the
+// API server.
+func (k *KubeGet) Invalidate() {
+	k.discoveryClient.Invalidate()
+	if resettable, ok := k.restMapper.(meta.ResettableRESTMapper); ok {
+		resettable.Reset()
+	}
+	k.shortcutExpander.Invalidate()
+}
+
+// GetOptions controls how GetWithOptions selects and paginates results, mirroring the
+// flags kubectl accepts on `kubectl get` (-l, --field-selector, -A).
+type GetOptions struct {
+	// LabelSelector restricts the returned items, as in metav1.ListOptions.
+	LabelSelector string
+	// FieldSelector restricts the returned items, as in metav1.ListOptions.
+	FieldSelector string
+	// AllNamespaces lists across every namespace, ignoring the namespace argument.
+	AllNamespaces bool
+	// Limit caps the number of items returned in one page. Zero means no limit.
+	Limit int64
+	// Continue resumes a previous paginated List call.
+	Continue string
+}
+
 // Get retrieves Kubernetes resources by name and namespace, returning the resolved GVR and resource list
 // If namespace is empty, retrieves cluster-scoped resources
 func (k *KubeGet) Get(ctx context.Context, resourceName, namespace string) (schema.GroupVersionResource, *unstructured.UnstructuredList, error) {
-	gvr, err := k.findGVR(resourceName)
+	return k.GetWithOptions(ctx, resourceName, namespace, GetOptions{})
+}
+
+// GetWithOptions retrieves Kubernetes resources by name, honoring label/field selectors,
+// pagination, and cross-namespace listing. When opts.AllNamespaces is true, namespace is
+// ignored and the returned items each carry their own namespace. When resourceName is a
+// category that resolves to more than one resource (e.g. "all"), the results from every
+// matching resource are merged into a single list and the returned GroupVersionResource
+// is the zero value; a category with a single resource returns that resource's GVR.
+func (k *KubeGet) GetWithOptions(ctx context.Context, resourceName, namespace string, opts GetOptions) (schema.GroupVersionResource, *unstructured.UnstructuredList, error) {
+	gvrs, err := k.resolveGVRs(resourceName)
+	if err != nil {
+		return schema.GroupVersionResource{}, nil, err
+	}
+
+	if len(gvrs) == 1 {
+		return k.listResource(ctx, gvrs[0], namespace, opts)
+	}
+
+	merged := &unstructured.UnstructuredList{}
+	merged.SetAPIVersion("v1")
+	merged.SetKind("List")
+	var errs []error
+	for _, gvr := range gvrs {
+		_, list, err := k.listResource(ctx, gvr, namespace, opts)
+		if err != nil {
+			// One resource in the category may be temporarily unreachable; keep
+			// aggregating the rest rather than failing the whole request, but still
+			// surface the error so the caller can tell an empty result from a
+			// genuinely empty cluster.
+			errs = append(errs, fmt.Errorf("failed to list %s: %w", gvr, err))
+			continue
+		}
+		merged.Items = append(merged.Items, list.Items...)
+	}
+
+	return schema.GroupVersionResource{}, merged, utilerrors.NewAggregate(errs)
+}
+
+// Resolve returns every GroupVersionResource that name matches, without fetching any
+// objects. A shortname, kind, or resource name resolves to exactly one GVR; a category
+// such as "all" resolves to every matching GVR.
+func (k *KubeGet) Resolve(resourceName string) ([]schema.GroupVersionResource, error) {
+	return k.resolveGVRs(resourceName)
+}
+
+// resolveGVRs resolves resourceName to one GVR, or to every GVR in a category.
+func (k *KubeGet) resolveGVRs(resourceName string) ([]schema.GroupVersionResource, error) {
+	if resourceName == "" {
+		return nil, fmt.Errorf("failed to find resource %q: resource name cannot be empty", resourceName)
+	}
+
+	if gvr, err := k.findGVR(resourceName); err == nil {
+		return []schema.GroupVersionResource{gvr}, nil
+	}
+
+	if k.shortcutExpander == nil {
+		return nil, fmt.Errorf("failed to find resource %q: resource not found in any API group", resourceName)
+	}
+
+	gvrs, err := k.shortcutExpander.Expand(resourceName)
 	if err != nil {
-		return schema.GroupVersionResource{}, nil, fmt.Errorf("failed to find resource %q: %w", resourceName, err)
+		return nil, fmt.Errorf("failed to find resource %q: %w", resourceName, err)
 	}
 
+	return gvrs, nil
+}
+
+// listResource lists a single resolved GVR, honoring label/field selectors, pagination,
+// and cross-namespace listing.
+func (k *KubeGet) listResource(ctx context.Context, gvr schema.GroupVersionResource, namespace string, opts GetOptions) (schema.GroupVersionResource, *unstructured.UnstructuredList, error) {
 	var resourceInterface dynamic.ResourceInterface
-	if namespace != "" {
+	if opts.AllNamespaces {
+		resourceInterface = k.dynamicClient.Resource(gvr)
+	} else if namespace != "" {
 		resourceInterface = k.dynamicClient.Resource(gvr).Namespace(namespace)
 	} else {
 		resourceInterface = k.dynamicClient.Resource(gvr)
 	}
 
-	list, err := resourceInterface.List(ctx, metav1.ListOptions{})
+	listOptions := metav1.ListOptions{
+		LabelSelector: opts.LabelSelector,
+		FieldSelector: opts.FieldSelector,
+		Limit:         opts.Limit,
+		Continue:      opts.Continue,
+	}
+
+	list, err := resourceInterface.List(ctx, listOptions)
 	if err != nil {
 		return gvr, nil, fmt.Errorf("failed to list resources: %w", err)
 	}
@@ -111,52 +223,20 @@ func (k *KubeGet) findGVR(resourceName string) (schema.GroupVersionResource, err
 		return mappings[0].Resource, nil
 	}
 
-	// Try case variations for kind names (e.g., "dspa" -> "DSPA")
-	kindVariations := []string{
-		resourceName,
-		strings.Title(resourceName),
-		strings.ToUpper(resourceName),
-		strings.ToUpper(string(resourceName[0])) + strings.ToLower(resourceName[1:]),
+	// Last resort: resolve shortnames, singular names, and kind case-variations via the
+	// shortcut expander. A category (e.g. "all") is rejected here since findGVR only
+	// ever returns a single GVR; resolveGVRs handles fanning out across categories.
+	if k.shortcutExpander == nil {
+		return schema.GroupVersionResource{}, fmt.Errorf("resource not found in any API group")
 	}
 
-	for _, kind := range kindVariations {
-		mappings, err := k.restMapper.RESTMappings(schema.GroupKind{Kind: kind})
-		if err == nil && len(mappings) > 0 {
-			return mappings[0].Resource, nil
-		}
-	}
-
-	// Last resort: try to find by resource shortnames or aliases
-	// This requires checking all available resources
-	apiResourceLists, err := k.discoveryClient.ServerPreferredResources()
+	gvrs, err := k.shortcutExpander.Expand(resourceName)
 	if err != nil {
-		return schema.GroupVersionResource{}, fmt.Errorf("failed to find resource %q: %w", resourceName, err)
+		return schema.GroupVersionResource{}, fmt.Errorf("resource not found in any API group")
 	}
-
-	for _, apiResourceList := range apiResourceLists {
-		if apiResourceList == nil {
-			continue
-		}
-
-		gv, err := schema.ParseGroupVersion(apiResourceList.GroupVersion)
-		if err != nil {
-			continue
-		}
-
-		for _, apiResource := range apiResourceList.APIResources {
-			// Check if the resourceName matches the resource name, kind, or any shortnames
-			if apiResource.Name == resourceName ||
-				strings.EqualFold(apiResource.Kind, resourceName) {
-				return gv.WithResource(apiResource.Name), nil
-			}
-
-			for _, shortName := range apiResource.ShortNames {
-				if shortName == resourceName {
-					return gv.WithResource(apiResource.Name), nil
-				}
-			}
-		}
+	if len(gvrs) != 1 {
+		return schema.GroupVersionResource{}, fmt.Errorf("%q matches %d resources", resourceName, len(gvrs))
 	}
 
-	return schema.GroupVersionResource{}, fmt.Errorf("failed to find resource %q: resource not found in any API group", resourceName)
+	return gvrs[0], nil
 }
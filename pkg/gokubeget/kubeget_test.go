@@ -178,6 +178,41 @@ func TestGet_ErrorHandling(t *testing.T) {
 	}
 }
 
+func TestGetWithOptions_ErrorHandling(t *testing.T) {
+	ctx := context.Background()
+	kubeget := &KubeGet{}
+
+	tests := []struct {
+		name         string
+		resourceName string
+		opts         GetOptions
+		expectError  bool
+	}{
+		{
+			name:         "empty resource name",
+			resourceName: "",
+			opts:         GetOptions{},
+			expectError:  true,
+		},
+		{
+			name:         "all namespaces with empty resource name",
+			resourceName: "",
+			opts:         GetOptions{AllNamespaces: true, LabelSelector: "app=foo"},
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := kubeget.GetWithOptions(ctx, tt.resourceName, "default", tt.opts)
+
+			if tt.expectError && err == nil {
+				t.Errorf("Expected error but got none")
+			}
+		})
+	}
+}
+
 func splitResourceName(resourceName string) []string {
 	if !containsString(resourceName, ".") {
 		return []string{resourceName}
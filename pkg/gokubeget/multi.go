@@ -0,0 +1,141 @@
+package gokubeget
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// defaultMultiKubeGetConcurrency bounds how many clusters MultiKubeGet.Get contacts at
+// once, so fanning out to a large kubeconfig doesn't open an unbounded number of
+// connections.
+const defaultMultiKubeGetConcurrency = 8
+
+// ClusterConfig names a single cluster context to fan a Get out to.
+type ClusterConfig struct {
+	Context string
+	Config  *rest.Config
+}
+
+// ClusterResult is one cluster's outcome from a MultiKubeGet.Get fan-out.
+type ClusterResult struct {
+	GVR  schema.GroupVersionResource
+	List *unstructured.UnstructuredList
+	Err  error
+}
+
+// MultiKubeGet runs Get concurrently against several clusters. GVR resolution happens
+// independently per cluster, since the CRDs installed (and therefore what a resource
+// name resolves to) can differ from cluster to cluster.
+type MultiKubeGet struct {
+	clusters    []ClusterConfig
+	concurrency int
+}
+
+// NewMultiKubeGet builds a MultiKubeGet from explicit (context, *rest.Config) pairs.
+func NewMultiKubeGet(clusters []ClusterConfig) *MultiKubeGet {
+	return &MultiKubeGet{clusters: clusters, concurrency: defaultMultiKubeGetConcurrency}
+}
+
+// NewMultiKubeGetFromKubeconfig resolves clusters from a kubeconfig file. When
+// contextNames is empty, every context defined in the kubeconfig is used.
+func NewMultiKubeGetFromKubeconfig(kubeconfigPath string, contextNames []string) (*MultiKubeGet, error) {
+	rawConfig, err := clientcmd.LoadFromFile(kubeconfigPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load kubeconfig %q: %w", kubeconfigPath, err)
+	}
+
+	if len(contextNames) == 0 {
+		for name := range rawConfig.Contexts {
+			contextNames = append(contextNames, name)
+		}
+	}
+
+	clusters := make([]ClusterConfig, 0, len(contextNames))
+	for _, name := range contextNames {
+		if _, ok := rawConfig.Contexts[name]; !ok {
+			return nil, fmt.Errorf("context %q not found in kubeconfig %q", name, kubeconfigPath)
+		}
+
+		config, err := clientcmd.NewNonInteractiveClientConfig(*rawConfig, name, &clientcmd.ConfigOverrides{}, nil).ClientConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to build client config for context %q: %w", name, err)
+		}
+
+		clusters = append(clusters, ClusterConfig{Context: name, Config: config})
+	}
+
+	return NewMultiKubeGet(clusters), nil
+}
+
+// Get runs Get concurrently across every configured cluster and returns one
+// ClusterResult per context. A single slow or unreachable cluster doesn't block the
+// others, and ctx cancellation makes Get return promptly: every not-yet-started call is
+// simply never issued, and Get stops waiting on every in-flight call the instant ctx is
+// done. It cannot, however, abort a call that's already in flight — discovery (via
+// restmapper.NewDeferredDiscoveryRESTMapper) takes no context.Context, so a cluster stuck
+// dialing or doing DNS resolution keeps running in the background until its own OS-level
+// timeout, it just no longer occupies one of the concurrency slots or delays the others.
+func (m *MultiKubeGet) Get(ctx context.Context, resourceName, namespace string) map[string]ClusterResult {
+	results := make(map[string]ClusterResult, len(m.clusters))
+	var mu sync.Mutex
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(m.concurrency)
+
+	for _, cluster := range m.clusters {
+		cluster := cluster
+		g.Go(func() error {
+			result := m.getClusterCtx(gctx, cluster, resourceName, namespace)
+
+			mu.Lock()
+			results[cluster.Context] = result
+			mu.Unlock()
+
+			// Per-cluster errors are carried in the result, not returned here, so
+			// one failing cluster doesn't cancel gctx for the rest.
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	return results
+}
+
+// getClusterCtx runs getCluster in its own goroutine and returns as soon as either it
+// finishes or ctx is done, whichever comes first. This bounds how long Get waits on a
+// single cluster even though the underlying discovery calls can't be cancelled directly;
+// a goroutine left behind by a cancelled call exits on its own once that timeout elapses.
+func (m *MultiKubeGet) getClusterCtx(ctx context.Context, cluster ClusterConfig, resourceName, namespace string) ClusterResult {
+	if err := ctx.Err(); err != nil {
+		return ClusterResult{Err: fmt.Errorf("cluster %q: %w", cluster.Context, err)}
+	}
+
+	done := make(chan ClusterResult, 1)
+	go func() {
+		done <- m.getCluster(ctx, cluster, resourceName, namespace)
+	}()
+
+	select {
+	case <-ctx.Done():
+		return ClusterResult{Err: fmt.Errorf("cluster %q: %w", cluster.Context, ctx.Err())}
+	case result := <-done:
+		return result
+	}
+}
+
+func (m *MultiKubeGet) getCluster(ctx context.Context, cluster ClusterConfig, resourceName, namespace string) ClusterResult {
+	kubeget, err := NewKubeGet(cluster.Config)
+	if err != nil {
+		return ClusterResult{Err: fmt.Errorf("failed to create client: %w", err)}
+	}
+
+	gvr, list, err := kubeget.Get(ctx, resourceName, namespace)
+	return ClusterResult{GVR: gvr, List: list, Err: err}
+}
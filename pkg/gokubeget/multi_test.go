@@ -0,0 +1,68 @@
+package gokubeget
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"k8s.io/client-go/rest"
+)
+
+func TestNewMultiKubeGetFromKubeconfig_MissingFile(t *testing.T) {
+	_, err := NewMultiKubeGetFromKubeconfig("/nonexistent/kubeconfig", nil)
+	if err == nil {
+		t.Error("expected error for missing kubeconfig file, got none")
+	}
+}
+
+func TestMultiKubeGet_Get_NoClusters(t *testing.T) {
+	multiKubeGet := NewMultiKubeGet(nil)
+
+	results := multiKubeGet.Get(context.Background(), "pods", "default")
+
+	if len(results) != 0 {
+		t.Errorf("expected no results for an empty cluster list, got %d", len(results))
+	}
+}
+
+// TestMultiKubeGet_Get_EachGoroutineSeesItsOwnCluster guards against the closure in Get
+// capturing the loop's cluster variable by reference: without a per-iteration copy,
+// concurrent goroutines can race on the shared variable and produce results keyed to the
+// wrong context. Every cluster here gets a distinct, unreachable host so the only way to
+// tell them apart is which context each result ends up keyed under.
+func TestMultiKubeGet_Get_EachGoroutineSeesItsOwnCluster(t *testing.T) {
+	const clusterCount = 20
+
+	clusters := make([]ClusterConfig, clusterCount)
+	for i := range clusters {
+		clusters[i] = ClusterConfig{
+			Context: fmt.Sprintf("context-%d", i),
+			Config:  &rest.Config{Host: fmt.Sprintf("https://cluster-%d.invalid:6443", i)},
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	multiKubeGet := NewMultiKubeGet(clusters)
+	results := multiKubeGet.Get(ctx, "pods", "default")
+
+	if len(results) != clusterCount {
+		t.Fatalf("expected %d results, got %d", clusterCount, len(results))
+	}
+	for i := range clusters {
+		name := fmt.Sprintf("context-%d", i)
+		if _, ok := results[name]; !ok {
+			t.Errorf("expected a result keyed under %q, got results for: %v", name, resultKeys(results))
+		}
+	}
+}
+
+func resultKeys(results map[string]ClusterResult) []string {
+	keys := make([]string, 0, len(results))
+	for k := range results {
+		keys = append(keys, k)
+	}
+	return keys
+}
@@ -0,0 +1,256 @@
+// Package printers renders an *unstructured.UnstructuredList the way kubectl renders
+// `kubectl get` output, supporting the same handful of `-o` formats.
+package printers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/client-go/util/jsonpath"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	kindTable      = "table"
+	kindWide       = "wide"
+	kindName       = "name"
+	kindJSON       = "json"
+	kindYAML       = "yaml"
+	kindJSONPath   = "jsonpath"
+	kindGoTemplate = "go-template"
+)
+
+// Format selects how a resource list is rendered, mirroring the values accepted by
+// kubectl's `-o` flag.
+type Format struct {
+	kind string
+
+	jsonPathExpr  string
+	goTemplateSrc string
+}
+
+// ParseFormat parses a `-o` value such as "table", "wide", "json", "yaml", "name",
+// "jsonpath={.items[*].metadata.name}", or "go-template={{.metadata.name}}". An empty
+// string parses to the default table format.
+func ParseFormat(s string) (Format, error) {
+	switch {
+	case s == "" || s == kindTable:
+		return Format{kind: kindTable}, nil
+	case s == kindWide:
+		return Format{kind: kindWide}, nil
+	case s == kindName:
+		return Format{kind: kindName}, nil
+	case s == kindJSON:
+		return Format{kind: kindJSON}, nil
+	case s == kindYAML:
+		return Format{kind: kindYAML}, nil
+	case strings.HasPrefix(s, kindJSONPath+"="):
+		return Format{kind: kindJSONPath, jsonPathExpr: strings.TrimPrefix(s, kindJSONPath+"=")}, nil
+	case strings.HasPrefix(s, kindGoTemplate+"="):
+		return Format{kind: kindGoTemplate, goTemplateSrc: strings.TrimPrefix(s, kindGoTemplate+"=")}, nil
+	default:
+		return Format{}, fmt.Errorf("unsupported output format %q", s)
+	}
+}
+
+// TableFetcher returns the server-side Table representation of a resource list, as
+// produced by the `application/json;as=Table;v=1;g=meta.k8s.io` content type that
+// kubectl negotiates for `kubectl get`. It may return a nil *metav1.Table (with a nil
+// error) when the server does not support it, in which case Print falls back to
+// NAME/AGE columns built from the UnstructuredList.
+type TableFetcher func() (*metav1.Table, error)
+
+// Print renders list in the given format to w. fetchTable, which may be nil, supplies
+// server-side printer columns for the "table" and "wide" formats. allNamespaces adds a
+// NAMESPACE column to those same formats, mirroring kubectl's `-A` behavior; it has no
+// effect on the other formats, which already render each item's full metadata.
+func Print(w io.Writer, list *unstructured.UnstructuredList, format Format, fetchTable TableFetcher, allNamespaces bool) error {
+	switch format.kind {
+	case kindName:
+		return printName(w, list)
+	case kindJSON:
+		return printJSON(w, list)
+	case kindYAML:
+		return printYAML(w, list)
+	case kindJSONPath:
+		return printJSONPath(w, list, format.jsonPathExpr)
+	case kindGoTemplate:
+		return printGoTemplate(w, list, format.goTemplateSrc)
+	case kindWide:
+		return printTable(w, list, fetchTable, true, allNamespaces)
+	default:
+		return printTable(w, list, fetchTable, false, allNamespaces)
+	}
+}
+
+func printName(w io.Writer, list *unstructured.UnstructuredList) error {
+	for _, item := range list.Items {
+		kind := strings.ToLower(item.GetKind())
+		if kind == "" {
+			kind = "resource"
+		}
+		if _, err := fmt.Fprintf(w, "%s/%s\n", kind, item.GetName()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printJSON(w io.Writer, list *unstructured.UnstructuredList) error {
+	data, err := json.MarshalIndent(list.Object, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal list as JSON: %w", err)
+	}
+	_, err = fmt.Fprintln(w, string(data))
+	return err
+}
+
+func printYAML(w io.Writer, list *unstructured.UnstructuredList) error {
+	data, err := yaml.Marshal(list.Object)
+	if err != nil {
+		return fmt.Errorf("failed to marshal list as YAML: %w", err)
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+func printJSONPath(w io.Writer, list *unstructured.UnstructuredList, expr string) error {
+	jp := jsonpath.New("out")
+	if err := jp.Parse(expr); err != nil {
+		return fmt.Errorf("invalid jsonpath expression %q: %w", expr, err)
+	}
+
+	if err := jp.Execute(w, list.Object); err != nil {
+		return fmt.Errorf("failed to execute jsonpath expression %q: %w", expr, err)
+	}
+	_, err := fmt.Fprintln(w)
+	return err
+}
+
+func printGoTemplate(w io.Writer, list *unstructured.UnstructuredList, src string) error {
+	tmpl, err := template.New("out").Parse(src)
+	if err != nil {
+		return fmt.Errorf("invalid go-template %q: %w", src, err)
+	}
+
+	for _, item := range list.Items {
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, item.Object); err != nil {
+			return fmt.Errorf("failed to execute go-template: %w", err)
+		}
+		if _, err := fmt.Fprintln(w, buf.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printTable(w io.Writer, list *unstructured.UnstructuredList, fetchTable TableFetcher, wide, allNamespaces bool) error {
+	if len(list.Items) == 0 {
+		_, err := fmt.Fprintln(w, "No resources found.")
+		return err
+	}
+
+	if fetchTable != nil {
+		table, err := fetchTable()
+		if err == nil && table != nil {
+			return printServerTable(w, table, wide, allNamespaces)
+		}
+	}
+	return printFallbackTable(w, list, allNamespaces)
+}
+
+func printServerTable(w io.Writer, table *metav1.Table, wide, allNamespaces bool) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 3, ' ', 0)
+
+	header := []string{}
+	if allNamespaces {
+		header = append(header, "NAMESPACE")
+	}
+	for _, col := range table.ColumnDefinitions {
+		if !wide && col.Priority > 0 {
+			continue
+		}
+		header = append(header, strings.ToUpper(col.Name))
+	}
+	if _, err := fmt.Fprintln(tw, strings.Join(header, "\t")); err != nil {
+		return err
+	}
+
+	for _, row := range table.Rows {
+		cells := []string{}
+		if allNamespaces {
+			cells = append(cells, rowNamespace(row))
+		}
+		for i, col := range table.ColumnDefinitions {
+			if !wide && col.Priority > 0 {
+				continue
+			}
+			if i < len(row.Cells) {
+				cells = append(cells, fmt.Sprintf("%v", row.Cells[i]))
+			} else {
+				cells = append(cells, "<none>")
+			}
+		}
+		if _, err := fmt.Fprintln(tw, strings.Join(cells, "\t")); err != nil {
+			return err
+		}
+	}
+
+	return tw.Flush()
+}
+
+// rowNamespace recovers the namespace of a server Table row from its embedded object
+// metadata, falling back to empty when the server left Object unset (e.g. older API
+// servers that return Table rows without the partial metadata the Table API normally
+// includes).
+func rowNamespace(row metav1.TableRow) string {
+	if row.Object.Raw == nil {
+		return ""
+	}
+	var partial metav1.PartialObjectMetadata
+	if err := json.Unmarshal(row.Object.Raw, &partial); err != nil {
+		return ""
+	}
+	return partial.Namespace
+}
+
+// printFallbackTable renders NAME/AGE columns when the server does not return a Table
+// response, e.g. because fetchTable was nil or the API server predates table support.
+func printFallbackTable(w io.Writer, list *unstructured.UnstructuredList, allNamespaces bool) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 3, ' ', 0)
+
+	header := "NAME\tAGE"
+	if allNamespaces {
+		header = "NAMESPACE\t" + header
+	}
+	if _, err := fmt.Fprintln(tw, header); err != nil {
+		return err
+	}
+
+	for _, item := range list.Items {
+		age := "<unknown>"
+		if ts := item.GetCreationTimestamp(); !ts.IsZero() {
+			age = duration.HumanDuration(time.Since(ts.Time))
+		}
+
+		row := fmt.Sprintf("%s\t%s", item.GetName(), age)
+		if allNamespaces {
+			row = item.GetNamespace() + "\t" + row
+		}
+		if _, err := fmt.Fprintln(tw, row); err != nil {
+			return err
+		}
+	}
+
+	return tw.Flush()
+}
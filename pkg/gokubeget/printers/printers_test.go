@@ -0,0 +1,157 @@
+package printers
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expectKind  string
+		expectError bool
+	}{
+		{name: "empty defaults to table", input: "", expectKind: kindTable},
+		{name: "table", input: "table", expectKind: kindTable},
+		{name: "wide", input: "wide", expectKind: kindWide},
+		{name: "name", input: "name", expectKind: kindName},
+		{name: "json", input: "json", expectKind: kindJSON},
+		{name: "yaml", input: "yaml", expectKind: kindYAML},
+		{name: "jsonpath", input: "jsonpath={.items[*].metadata.name}", expectKind: kindJSONPath},
+		{name: "go-template", input: "go-template={{.metadata.name}}", expectKind: kindGoTemplate},
+		{name: "unsupported", input: "csv", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			format, err := ParseFormat(tt.input)
+
+			if tt.expectError {
+				if err == nil {
+					t.Error("expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if format.kind != tt.expectKind {
+				t.Errorf("expected kind %q, got %q", tt.expectKind, format.kind)
+			}
+		})
+	}
+}
+
+func TestPrint_Name(t *testing.T) {
+	list := &unstructured.UnstructuredList{
+		Items: []unstructured.Unstructured{
+			{Object: map[string]interface{}{"kind": "Pod", "metadata": map[string]interface{}{"name": "foo"}}},
+		},
+	}
+
+	format, err := ParseFormat("name")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := Print(&buf, list, format, nil, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := buf.String(); got != "pod/foo\n" {
+		t.Errorf("expected %q, got %q", "pod/foo\n", got)
+	}
+}
+
+func TestPrint_FallbackTable(t *testing.T) {
+	list := &unstructured.UnstructuredList{
+		Items: []unstructured.Unstructured{
+			{Object: map[string]interface{}{"kind": "Pod", "metadata": map[string]interface{}{"name": "foo"}}},
+		},
+	}
+
+	format, err := ParseFormat("table")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := Print(&buf, list, format, nil, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "NAME") || !strings.Contains(out, "foo") {
+		t.Errorf("expected fallback table to contain NAME and foo, got %q", out)
+	}
+}
+
+func TestPrint_FallbackTable_Empty(t *testing.T) {
+	list := &unstructured.UnstructuredList{}
+
+	format, err := ParseFormat("table")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := Print(&buf, list, format, nil, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := buf.String(); got != "No resources found.\n" {
+		t.Errorf("expected %q, got %q", "No resources found.\n", got)
+	}
+}
+
+func TestPrint_JSON_Empty(t *testing.T) {
+	list := &unstructured.UnstructuredList{
+		Object: map[string]interface{}{"apiVersion": "v1", "kind": "List"},
+	}
+
+	format, err := ParseFormat("json")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := Print(&buf, list, format, nil, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := buf.String(); strings.Contains(got, "No resources found") {
+		t.Errorf("expected valid JSON for an empty list, got %q", got)
+	}
+}
+
+func TestPrint_FallbackTable_AllNamespaces(t *testing.T) {
+	list := &unstructured.UnstructuredList{
+		Items: []unstructured.Unstructured{
+			{Object: map[string]interface{}{
+				"kind":     "Pod",
+				"metadata": map[string]interface{}{"name": "foo", "namespace": "ns-a"},
+			}},
+		},
+	}
+
+	format, err := ParseFormat("table")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := Print(&buf, list, format, nil, true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "NAMESPACE") || !strings.Contains(out, "ns-a") {
+		t.Errorf("expected fallback table to contain a NAMESPACE column with ns-a, got %q", out)
+	}
+}
@@ -0,0 +1,187 @@
+package gokubeget
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+)
+
+// ShortcutExpander resolves kubectl-style resource shortcuts (shortnames, kinds,
+// singular/plural resource names, and categories such as "all") to one or more
+// GroupVersionResources, the way kubectl's own shortcut expander does. Unlike a plain
+// meta.RESTMapper, it also understands categories, since CRD-defined categories aren't
+// part of the REST mapping.
+type ShortcutExpander struct {
+	discoveryClient discovery.CachedDiscoveryInterface
+
+	mu    sync.RWMutex
+	index *shortcutIndex
+}
+
+// shortcutIndex is the built lookup table: exact-match names (shortnames, singular and
+// plural resource names, kinds) map to a single GVR, while categories map to every GVR
+// that advertises that category. A name claimed by more than one GVR (e.g. two groups
+// exposing the same shortname or Kind) is recorded in ambiguous instead of names, so
+// Expand can report it rather than silently picking whichever GVR was indexed last.
+type shortcutIndex struct {
+	names      map[string]schema.GroupVersionResource
+	ambiguous  map[string][]schema.GroupVersionResource
+	categories map[string][]schema.GroupVersionResource
+}
+
+// claim records that name resolves to gvr, moving name to ambiguous instead if some
+// other GVR has already claimed it.
+func (index *shortcutIndex) claim(name string, gvr schema.GroupVersionResource) {
+	if candidates, ok := index.ambiguous[name]; ok {
+		index.ambiguous[name] = appendUnique(candidates, gvr)
+		return
+	}
+	if existing, ok := index.names[name]; ok {
+		if existing == gvr {
+			return
+		}
+		delete(index.names, name)
+		index.ambiguous[name] = appendUnique([]schema.GroupVersionResource{existing}, gvr)
+		return
+	}
+	index.names[name] = gvr
+}
+
+func appendUnique(gvrs []schema.GroupVersionResource, gvr schema.GroupVersionResource) []schema.GroupVersionResource {
+	for _, existing := range gvrs {
+		if existing == gvr {
+			return gvrs
+		}
+	}
+	return append(gvrs, gvr)
+}
+
+// AmbiguousResourceError is returned by Expand when a shortname, kind, or resource name
+// is claimed by more than one group (e.g. two CRDs exposing the same shortname).
+// Callers should re-invoke with a fully qualified "<resource>.<version>.<group>" form to
+// pick one of Candidates explicitly.
+type AmbiguousResourceError struct {
+	ResourceName string
+	Candidates   []schema.GroupVersionResource
+}
+
+func (e *AmbiguousResourceError) Error() string {
+	candidates := make([]string, 0, len(e.Candidates))
+	for _, gvr := range e.Candidates {
+		candidates = append(candidates, gvr.String())
+	}
+	return fmt.Sprintf("%q is ambiguous, matches multiple resources: %s (re-invoke as \"<resource>.<version>.<group>\" to disambiguate)",
+		e.ResourceName, strings.Join(candidates, ", "))
+}
+
+// NewShortcutExpander creates an expander backed by discoveryClient. The index is built
+// lazily on first use and cached until Invalidate is called.
+func NewShortcutExpander(discoveryClient discovery.CachedDiscoveryInterface) *ShortcutExpander {
+	return &ShortcutExpander{discoveryClient: discoveryClient}
+}
+
+// Invalidate drops the cached index, forcing the next Expand call to rebuild it from
+// the discovery client.
+func (e *ShortcutExpander) Invalidate() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.index = nil
+}
+
+// Expand resolves name to every GroupVersionResource it matches. A category such as
+// "all" may resolve to many; a shortname, kind, or resource name normally resolves to
+// exactly one, but returns an *AmbiguousResourceError if more than one group claims it.
+func (e *ShortcutExpander) Expand(name string) ([]schema.GroupVersionResource, error) {
+	index, err := e.getIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	if gvrs, ok := index.categories[name]; ok {
+		return gvrs, nil
+	}
+	if candidates, ok := index.ambiguous[name]; ok {
+		return nil, &AmbiguousResourceError{ResourceName: name, Candidates: candidates}
+	}
+	if gvr, ok := index.names[name]; ok {
+		return []schema.GroupVersionResource{gvr}, nil
+	}
+	lower := strings.ToLower(name)
+	if candidates, ok := index.ambiguous[lower]; ok {
+		return nil, &AmbiguousResourceError{ResourceName: name, Candidates: candidates}
+	}
+	if gvr, ok := index.names[lower]; ok {
+		return []schema.GroupVersionResource{gvr}, nil
+	}
+
+	return nil, fmt.Errorf("no resource matches %q", name)
+}
+
+func (e *ShortcutExpander) getIndex() (*shortcutIndex, error) {
+	e.mu.RLock()
+	if e.index != nil {
+		index := e.index
+		e.mu.RUnlock()
+		return index, nil
+	}
+	e.mu.RUnlock()
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.index != nil {
+		return e.index, nil
+	}
+
+	if e.discoveryClient == nil {
+		return nil, fmt.Errorf("shortcut expander has no discovery client")
+	}
+
+	apiResourceLists, err := e.discoveryClient.ServerPreferredResources()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build shortcut index: %w", err)
+	}
+
+	index := &shortcutIndex{
+		names:      make(map[string]schema.GroupVersionResource),
+		ambiguous:  make(map[string][]schema.GroupVersionResource),
+		categories: make(map[string][]schema.GroupVersionResource),
+	}
+
+	for _, apiResourceList := range apiResourceLists {
+		if apiResourceList == nil {
+			continue
+		}
+
+		gv, err := schema.ParseGroupVersion(apiResourceList.GroupVersion)
+		if err != nil {
+			continue
+		}
+
+		for _, apiResource := range apiResourceList.APIResources {
+			if strings.Contains(apiResource.Name, "/") {
+				continue // skip subresources such as pods/status
+			}
+
+			gvr := gv.WithResource(apiResource.Name)
+
+			index.claim(apiResource.Name, gvr)
+			index.claim(strings.ToLower(apiResource.Kind), gvr)
+			if apiResource.SingularName != "" {
+				index.claim(apiResource.SingularName, gvr)
+			}
+			for _, shortName := range apiResource.ShortNames {
+				index.claim(shortName, gvr)
+			}
+
+			for _, category := range apiResource.Categories {
+				index.categories[category] = append(index.categories[category], gvr)
+			}
+		}
+	}
+
+	e.index = index
+	return index, nil
+}
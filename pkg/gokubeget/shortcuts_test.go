@@ -0,0 +1,82 @@
+package gokubeget
+
+import (
+	"errors"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestShortcutExpander_Expand_NilDiscoveryClient(t *testing.T) {
+	expander := NewShortcutExpander(nil)
+
+	_, err := expander.Expand("pods")
+	if err == nil {
+		t.Error("expected error when discovery client is nil, got none")
+	}
+}
+
+func TestShortcutExpander_Expand_Ambiguous(t *testing.T) {
+	widgetsA := schema.GroupVersionResource{Group: "appsgroup", Version: "v1", Resource: "widgets"}
+	widgetsB := schema.GroupVersionResource{Group: "othergroup", Version: "v1", Resource: "widgets"}
+
+	expander := &ShortcutExpander{
+		index: &shortcutIndex{
+			names:      map[string]schema.GroupVersionResource{},
+			ambiguous:  map[string][]schema.GroupVersionResource{"widget": {widgetsA, widgetsB}},
+			categories: map[string][]schema.GroupVersionResource{},
+		},
+	}
+
+	_, err := expander.Expand("widget")
+
+	var ambiguous *AmbiguousResourceError
+	if !errors.As(err, &ambiguous) {
+		t.Fatalf("expected *AmbiguousResourceError, got %T: %v", err, err)
+	}
+	if len(ambiguous.Candidates) != 2 {
+		t.Errorf("expected 2 candidates, got %d", len(ambiguous.Candidates))
+	}
+}
+
+func TestShortcutIndex_Claim_MovesCollisionToAmbiguous(t *testing.T) {
+	widgetsA := schema.GroupVersionResource{Group: "appsgroup", Version: "v1", Resource: "widgets"}
+	widgetsB := schema.GroupVersionResource{Group: "othergroup", Version: "v1", Resource: "widgets"}
+
+	index := &shortcutIndex{
+		names:      map[string]schema.GroupVersionResource{},
+		ambiguous:  map[string][]schema.GroupVersionResource{},
+		categories: map[string][]schema.GroupVersionResource{},
+	}
+
+	index.claim("widget", widgetsA)
+	index.claim("widget", widgetsB)
+
+	if _, ok := index.names["widget"]; ok {
+		t.Error("expected \"widget\" to be removed from names once claimed by a second GVR")
+	}
+	if candidates := index.ambiguous["widget"]; len(candidates) != 2 {
+		t.Errorf("expected 2 ambiguous candidates, got %d", len(candidates))
+	}
+
+	// Re-claiming by the same GVR that already won a name must not make it ambiguous.
+	index.claim("pod", widgetsA)
+	index.claim("pod", widgetsA)
+	if _, ok := index.ambiguous["pod"]; ok {
+		t.Error("claiming the same GVR twice should not mark the name ambiguous")
+	}
+	if gvr := index.names["pod"]; gvr != widgetsA {
+		t.Errorf("expected pod to resolve to %v, got %v", widgetsA, gvr)
+	}
+}
+
+func TestShortcutExpander_Invalidate(t *testing.T) {
+	expander := NewShortcutExpander(nil)
+
+	// Invalidate must be safe to call even before an index has ever been built.
+	expander.Invalidate()
+
+	if expander.index != nil {
+		t.Error("expected index to remain nil after Invalidate on an unbuilt expander")
+	}
+}
@@ -0,0 +1,66 @@
+package gokubeget
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// tableAcceptHeader is the content type kubectl negotiates for `kubectl get` to have the
+// API server render its own NAME/READY/STATUS-style printer columns, instead of the
+// client guessing columns from the raw object.
+const tableAcceptHeader = "application/json;as=Table;v=1;g=meta.k8s.io"
+
+// FetchTable requests the server-side Table representation (the same one `kubectl get`
+// renders) for resourceName/namespace. It returns a nil table, nil error when the API
+// server does not support table content negotiation, so callers can fall back to
+// rendering the UnstructuredList themselves.
+func (k *KubeGet) FetchTable(ctx context.Context, resourceName, namespace string, opts GetOptions) (*metav1.Table, error) {
+	gvr, err := k.findGVR(resourceName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find resource %q: %w", resourceName, err)
+	}
+
+	restClient := k.discoveryClient.RESTClient()
+	if restClient == nil {
+		return nil, nil
+	}
+
+	var pathSegments []string
+	if gvr.Group == "" {
+		pathSegments = append(pathSegments, "api", gvr.Version)
+	} else {
+		pathSegments = append(pathSegments, "apis", gvr.Group, gvr.Version)
+	}
+	if namespace != "" && !opts.AllNamespaces {
+		pathSegments = append(pathSegments, "namespaces", namespace)
+	}
+	pathSegments = append(pathSegments, gvr.Resource)
+
+	req := restClient.Get().
+		AbsPath(pathSegments...).
+		SetHeader("Accept", tableAcceptHeader)
+	if opts.LabelSelector != "" {
+		req = req.Param("labelSelector", opts.LabelSelector)
+	}
+	if opts.FieldSelector != "" {
+		req = req.Param("fieldSelector", opts.FieldSelector)
+	}
+	if opts.Limit > 0 {
+		req = req.Param("limit", fmt.Sprintf("%d", opts.Limit))
+	}
+	if opts.Continue != "" {
+		req = req.Param("continue", opts.Continue)
+	}
+
+	var table metav1.Table
+	if err := req.Do(ctx).Into(&table); err != nil {
+		// The server may not support table content negotiation (e.g. an old API
+		// server, or an aggregated API that never implemented it); let the caller
+		// fall back to rendering the UnstructuredList.
+		return nil, nil
+	}
+
+	return &table, nil
+}
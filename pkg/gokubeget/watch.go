@@ -0,0 +1,131 @@
+package gokubeget
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+)
+
+// Event wraps a single watch notification for a resource.
+type Event struct {
+	Type   watch.EventType
+	Object *unstructured.Unstructured
+}
+
+// WatchOptions controls how Watch selects the resources to stream, mirroring
+// `kubectl get -w`.
+type WatchOptions struct {
+	// LabelSelector restricts the watched items, as in metav1.ListOptions.
+	LabelSelector string
+	// FieldSelector restricts the watched items, as in metav1.ListOptions.
+	FieldSelector string
+}
+
+// Watch streams ADDED/MODIFIED/DELETED events for a resource, resolving the GVR the same
+// way Get does. The returned channel is closed when ctx is done or the watch cannot be
+// restarted. When the watch ends normally, Watch re-establishes it from the last observed
+// resourceVersion. If the server reports the watch's resourceVersion has expired, Watch
+// instead re-lists the resource and re-establishes the watch from the list's
+// resourceVersion.
+func (k *KubeGet) Watch(ctx context.Context, resourceName, namespace string, opts WatchOptions) (<-chan Event, error) {
+	gvr, err := k.findGVR(resourceName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find resource %q: %w", resourceName, err)
+	}
+
+	var resourceInterface dynamic.ResourceInterface
+	if namespace != "" {
+		resourceInterface = k.dynamicClient.Resource(gvr).Namespace(namespace)
+	} else {
+		resourceInterface = k.dynamicClient.Resource(gvr)
+	}
+
+	events := make(chan Event)
+
+	go func() {
+		defer close(events)
+
+		resourceVersion := ""
+		for {
+			listOptions := metav1.ListOptions{
+				LabelSelector:   opts.LabelSelector,
+				FieldSelector:   opts.FieldSelector,
+				ResourceVersion: resourceVersion,
+			}
+
+			watcher, err := resourceInterface.Watch(ctx, listOptions)
+			if err != nil {
+				return
+			}
+
+			newResourceVersion, resync, ok := k.drainWatch(ctx, watcher, events)
+			if !ok {
+				return
+			}
+
+			if resync {
+				// The resourceVersion we'd otherwise resume from is exactly what the
+				// server just rejected - re-list instead of reopening Watch with it
+				// again, which would just repeat the same 410 forever.
+				list, err := resourceInterface.List(ctx, metav1.ListOptions{
+					LabelSelector: opts.LabelSelector,
+					FieldSelector: opts.FieldSelector,
+				})
+				if err != nil {
+					return
+				}
+				resourceVersion = list.GetResourceVersion()
+				continue
+			}
+
+			resourceVersion = newResourceVersion
+		}
+	}()
+
+	return events, nil
+}
+
+// drainWatch forwards events from watcher to events until the watch ends, ctx is done, or
+// the server reports the resourceVersion has expired. It returns the last observed
+// resourceVersion, whether the caller should re-list to obtain a fresh resourceVersion
+// before restarting the watch, and whether the caller should keep watching at all.
+func (k *KubeGet) drainWatch(ctx context.Context, watcher watch.Interface, events chan<- Event) (string, bool, bool) {
+	defer watcher.Stop()
+
+	lastResourceVersion := ""
+	for {
+		select {
+		case <-ctx.Done():
+			return "", false, false
+		case result, ok := <-watcher.ResultChan():
+			if !ok {
+				return lastResourceVersion, false, true
+			}
+
+			if result.Type == watch.Error {
+				if status, ok := result.Object.(*metav1.Status); ok && apierrors.IsResourceExpired(&apierrors.StatusError{ErrStatus: *status}) {
+					return "", true, true
+				}
+				return "", false, false
+			}
+
+			obj, ok := result.Object.(*unstructured.Unstructured)
+			if !ok {
+				continue
+			}
+
+			lastResourceVersion = obj.GetResourceVersion()
+
+			select {
+			case events <- Event{Type: result.Type, Object: obj}:
+			case <-ctx.Done():
+				return "", false, false
+			}
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package gokubeget
+
+import (
+	"context"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+func TestWatch_ErrorHandling(t *testing.T) {
+	ctx := context.Background()
+	kubeget := &KubeGet{}
+
+	tests := []struct {
+		name         string
+		resourceName string
+		expectError  bool
+	}{
+		{
+			name:         "empty resource name",
+			resourceName: "",
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := kubeget.Watch(ctx, tt.resourceName, "default", WatchOptions{})
+
+			if tt.expectError && err == nil {
+				t.Errorf("Expected error but got none")
+			}
+		})
+	}
+}
+
+// TestDrainWatch_ExpiredResourceVersionTriggersResync guards against reopening Watch with
+// the same resourceVersion the server just rejected, which would reconnect and get the
+// same 410 Gone forever instead of re-listing.
+func TestDrainWatch_ExpiredResourceVersionTriggersResync(t *testing.T) {
+	watcher := newFakeWatcher()
+	watcher.send(watch.Event{
+		Type: watch.Error,
+		Object: &metav1.Status{
+			Status:  metav1.StatusFailure,
+			Reason:  metav1.StatusReasonExpired,
+			Message: apierrors.NewResourceExpired("resourceVersion too old").Error(),
+		},
+	})
+
+	kubeget := &KubeGet{}
+	events := make(chan Event)
+	resourceVersion, resync, ok := kubeget.drainWatch(context.Background(), watcher, events)
+
+	if !ok {
+		t.Fatal("expected drainWatch to report the caller should keep watching")
+	}
+	if !resync {
+		t.Error("expected resync=true so Watch re-lists instead of reusing the expired resourceVersion")
+	}
+	if resourceVersion != "" {
+		t.Errorf("expected resourceVersion to be cleared, got %q", resourceVersion)
+	}
+	if !watcher.stopped {
+		t.Error("expected drainWatch to stop the watcher")
+	}
+}
+
+// fakeWatcher is a minimal watch.Interface for feeding drainWatch specific events.
+type fakeWatcher struct {
+	ch      chan watch.Event
+	stopped bool
+}
+
+func newFakeWatcher() *fakeWatcher {
+	return &fakeWatcher{ch: make(chan watch.Event, 1)}
+}
+
+func (f *fakeWatcher) send(event watch.Event) {
+	f.ch <- event
+}
+
+func (f *fakeWatcher) Stop() {
+	f.stopped = true
+}
+
+func (f *fakeWatcher) ResultChan() <-chan watch.Event {
+	return f.ch
+}